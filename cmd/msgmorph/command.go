@@ -0,0 +1,196 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	msgmorph "github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// errFlags reports invalid flags. The flag package has already printed the
+// problem and the command's usage.
+var errFlags = errors.New("invalid flags")
+
+// env holds the standard streams used by a command.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// command is a contacts command's flag set, with the flags shared by every
+// command.
+type command struct {
+	*flag.FlagSet
+	env *env
+
+	profile string
+	project string
+	output  string
+	verbose bool
+}
+
+// newCommand creates the flag set of the command with the given name.
+// arguments and description are shown in the command's usage.
+func newCommand(e *env, name, arguments, description string) *command {
+	cmd := &command{
+		FlagSet: flag.NewFlagSet("contacts "+name, flag.ContinueOnError),
+		env:     e,
+		output:  formatJSON,
+	}
+	cmd.SetOutput(e.stderr)
+	cmd.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: msgmorph contacts %s %s\n\n%s\n\nFlags:\n", name, arguments, description)
+		cmd.PrintDefaults()
+	}
+
+	cmd.StringVar(&cmd.profile, "profile", "", "use the named configuration `profile` instead of MSGMORPH_* variables")
+	cmd.StringVar(&cmd.project, "project", "", "project `ID`, overriding the default project")
+	cmd.BoolVar(&cmd.verbose, "v", false, "log API requests to standard error")
+	return cmd
+}
+
+// outputFlag defines the -output flag.
+func (c *command) outputFlag() {
+	c.StringVar(&c.output, "output", formatJSON, "output `format`: json, table or csv")
+}
+
+// parse parses args, in which flags and positional arguments may be mixed,
+// and returns the positional arguments. At most maxArgs are accepted.
+func (c *command) parse(args []string, maxArgs int) ([]string, error) {
+	var positional []string
+	for {
+		if err := c.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errFlags
+		}
+
+		args = c.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) > maxArgs {
+		return nil, c.usageErrorf("unexpected argument %q", positional[maxArgs])
+	}
+	if !validFormat(c.output) {
+		return nil, c.usageErrorf("unknown output format %q", c.output)
+	}
+	return positional, nil
+}
+
+// usageErrorf returns a usageError for the command.
+func (c *command) usageErrorf(format string, args ...interface{}) error {
+	return &usageError{
+		msg: fmt.Sprintf("%s: %s (run \"msgmorph %s -h\" for usage)", c.Name(), fmt.Sprintf(format, args...), c.Name()),
+	}
+}
+
+// client creates the API client configured by the environment or -profile,
+// and -project.
+func (c *command) client() (*msgmorph.Client, error) {
+	opts := []msgmorph.ClientOption{
+		msgmorph.WithRetryPolicy(msgmorph.DefaultRetryPolicy()),
+	}
+	if c.verbose {
+		handler := slog.NewTextHandler(c.env.stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+		opts = append(opts, msgmorph.WithLogger(slog.New(handler)))
+	}
+
+	var client *msgmorph.Client
+	var err error
+	if c.profile != "" {
+		client, err = msgmorph.NewClientFromProfile(c.profile, opts...)
+	} else {
+		client, err = msgmorph.NewClientFromEnv(opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.project != "" {
+		client = client.ForProject(c.project)
+	}
+	return client, nil
+}
+
+// printer returns the printer for the -output format.
+func (c *command) printer() printer {
+	return printer{format: c.output, w: c.env.stdout}
+}
+
+// infof writes a message to standard error.
+func (c *command) infof(format string, args ...interface{}) {
+	fmt.Fprintf(c.env.stderr, format+"\n", args...)
+}
+
+// listParams defines the flags that filter and sort contacts, and returns
+// the parameters they set.
+func (c *command) listParams() *msgmorph.ListContactsParams {
+	params := &msgmorph.ListContactsParams{}
+
+	c.StringVar(&params.Email, "email", "", "only contacts with this email `address`")
+	c.StringVar(&params.ExternalID, "external-id", "", "only the contact with this external `ID`")
+	c.Func("feedback-sent", "only contacts to which feedback was sent (true) or not (false)", func(value string) error {
+		sent, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		params.FeedbackSent = &sent
+		return nil
+	})
+	c.Func("created-after", "only contacts created after this RFC 3339 `time`", timeFlag(&params.CreatedAfter))
+	c.Func("created-before", "only contacts created before this RFC 3339 `time`", timeFlag(&params.CreatedBefore))
+	c.Func("sort", "sort by `field`: createdAt, updatedAt, email or name", func(value string) error {
+		switch field := msgmorph.ContactSortField(value); field {
+		case msgmorph.ContactSortCreatedAt, msgmorph.ContactSortUpdatedAt, msgmorph.ContactSortEmail, msgmorph.ContactSortName:
+			params.SortBy = field
+			return nil
+		}
+		return fmt.Errorf("unknown sort field %q", value)
+	})
+	c.Func("order", "sort `order`: asc or desc", func(value string) error {
+		switch order := msgmorph.SortOrder(value); order {
+		case msgmorph.SortAsc, msgmorph.SortDesc:
+			params.SortOrder = order
+			return nil
+		}
+		return fmt.Errorf("unknown sort order %q", value)
+	})
+
+	return params
+}
+
+// idempotencyKeyFlag defines the -idempotency-key flag and returns the
+// request options it sets.
+func (c *command) idempotencyKeyFlag() func() []msgmorph.RequestOption {
+	key := c.String("idempotency-key", "", "idempotency `key`, making the command safe to repeat")
+	return func() []msgmorph.RequestOption {
+		if *key == "" {
+			return nil
+		}
+		return []msgmorph.RequestOption{msgmorph.WithIdempotencyKey(*key)}
+	}
+}
+
+// timeFlag returns a flag.Func that parses an RFC 3339 time into *t.
+func timeFlag(t **time.Time) func(string) error {
+	return func(value string) error {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid RFC 3339 time %q", value)
+		}
+		*t = &parsed
+		return nil
+	}
+}
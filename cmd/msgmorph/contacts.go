@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	msgmorph "github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// contactCommands are the contacts commands by name.
+var contactCommands = map[string]func(ctx context.Context, e *env, args []string) error{
+	"create": contactsCreate,
+	"get":    contactsGet,
+	"list":   contactsList,
+	"update": contactsUpdate,
+	"delete": contactsDelete,
+	"upsert": contactsUpsert,
+	"import": contactsImport,
+	"export": contactsExport,
+}
+
+// contactsCreate implements "contacts create".
+func contactsCreate(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "create", "-external-id ID -email ADDRESS [flags]", "Create a contact.")
+	cmd.outputFlag()
+	input := contactInputFlags(cmd)
+	reqOpts := cmd.idempotencyKeyFlag()
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+	contact, err := client.Contacts.Create(ctx, *input, reqOpts()...)
+	if err != nil {
+		return err
+	}
+	return cmd.printer().contact(contact)
+}
+
+// contactsGet implements "contacts get".
+func contactsGet(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "get", "ID | -external-id ID [flags]", "Get a contact by its MsgMorph ID or by your system's user ID.")
+	cmd.outputFlag()
+	externalID := cmd.String("external-id", "", "get the contact by your system's user `ID`")
+	ids, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if (len(ids) == 0) == (*externalID == "") {
+		return cmd.usageErrorf("either an ID or -external-id is required")
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+	var contact *msgmorph.Contact
+	if *externalID != "" {
+		contact, err = client.Contacts.GetByExternalID(ctx, "", *externalID)
+	} else {
+		contact, err = client.Contacts.Get(ctx, ids[0])
+	}
+	if err != nil {
+		return err
+	}
+	return cmd.printer().contact(contact)
+}
+
+// contactsList implements "contacts list".
+func contactsList(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "list", "[flags]", "List the contacts of a project, one page at a time unless -all is set.")
+	cmd.outputFlag()
+	params := cmd.listParams()
+	cmd.IntVar(&params.Limit, "limit", 0, "maximum `number` of contacts per page")
+	cmd.StringVar(&params.Cursor, "cursor", "", "`cursor` of the page to list, from a previous list")
+	all := cmd.Bool("all", false, "list every page")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+
+	if *all {
+		var contacts []msgmorph.Contact
+		for contact, err := range client.Contacts.All(ctx, *params) {
+			if err != nil {
+				return err
+			}
+			contacts = append(contacts, contact)
+		}
+		return cmd.printer().contacts(contacts)
+	}
+
+	page, err := client.Contacts.ListPage(ctx, *params)
+	if err != nil {
+		return err
+	}
+	if err := cmd.printer().contacts(page.Data); err != nil {
+		return err
+	}
+	if page.HasMore && page.NextCursor != "" {
+		cmd.infof("More contacts are available: add -cursor %s to list the next page.", page.NextCursor)
+	}
+	return nil
+}
+
+// contactsUpdate implements "contacts update".
+func contactsUpdate(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "update", "ID [-email ADDRESS] [-name NAME] [flags]", "Update a contact's email address or name.")
+	cmd.outputFlag()
+	var input msgmorph.UpdateContactInput
+	cmd.StringVar(&input.Email, "email", "", "new email `address`")
+	cmd.StringVar(&input.Name, "name", "", "new display `name`")
+	reqOpts := cmd.idempotencyKeyFlag()
+	ids, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return cmd.usageErrorf("a contact ID is required")
+	}
+	if input.Email == "" && input.Name == "" {
+		return cmd.usageErrorf("nothing to update: set -email or -name")
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+	contact, err := client.Contacts.Update(ctx, ids[0], input, reqOpts()...)
+	if err != nil {
+		return err
+	}
+	return cmd.printer().contact(contact)
+}
+
+// contactsDelete implements "contacts delete".
+func contactsDelete(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "delete", "ID [flags]", "Delete a contact.")
+	ids, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return cmd.usageErrorf("a contact ID is required")
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+	if err := client.Contacts.Delete(ctx, ids[0]); err != nil {
+		return err
+	}
+	cmd.infof("Deleted contact %s.", ids[0])
+	return nil
+}
+
+// contactsUpsert implements "contacts upsert".
+func contactsUpsert(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "upsert", "-external-id ID -email ADDRESS [flags]",
+		"Create a contact, or update its email address and name if a contact with the external ID exists.")
+	cmd.outputFlag()
+	input := contactInputFlags(cmd)
+	reqOpts := cmd.idempotencyKeyFlag()
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+	contact, created, err := client.Contacts.Upsert(ctx, *input, reqOpts()...)
+	if err != nil {
+		return err
+	}
+	if created {
+		cmd.infof("Created contact %s.", contact.ID)
+	} else {
+		cmd.infof("Updated contact %s.", contact.ID)
+	}
+	return cmd.printer().contact(contact)
+}
+
+// importReport is the outcome of "contacts import".
+type importReport struct {
+	// Rows is the number of valid rows read.
+	Rows int `json:"rows"`
+
+	// Invalid is the number of invalid rows, which were not imported.
+	Invalid int `json:"invalid"`
+
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+
+	// Errors lists the invalid rows, then the failed contacts.
+	Errors []importError `json:"errors,omitempty"`
+}
+
+// importError describes a row that was not imported.
+type importError struct {
+	// Line is the row's line number. It is only known for invalid rows.
+	Line int `json:"line,omitempty"`
+
+	ExternalID string             `json:"externalId,omitempty"`
+	Email      string             `json:"email,omitempty"`
+	Code       msgmorph.ErrorCode `json:"code"`
+	Message    string             `json:"message"`
+}
+
+// contactsImport implements "contacts import".
+func contactsImport(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "import", "[-file PATH] [flags]",
+		"Create contacts from a CSV or NDJSON file, skipping those whose external ID exists,\n"+
+			"or update them with -upsert. CSV files must start with a header row.")
+	cmd.outputFlag()
+	file := cmd.String("file", "-", "`path` of the file to import, or - for standard input")
+	format := cmd.String("format", "", "file `format`: csv or ndjson (default from the file extension, or csv)")
+	importOpts := msgmorph.ImportOptions{HeaderMapping: map[string]msgmorph.ContactField{}}
+	cmd.Func("map", "map a column to a contact field, as `HEADER=FIELD` (repeatable)", func(value string) error {
+		i := strings.LastIndex(value, "=")
+		if i < 0 {
+			return fmt.Errorf("%q is not HEADER=FIELD", value)
+		}
+		field := msgmorph.ContactField(value[i+1:])
+		switch field {
+		case msgmorph.ContactFieldExternalID, msgmorph.ContactFieldEmail, msgmorph.ContactFieldName, msgmorph.ContactFieldProjectID:
+			importOpts.HeaderMapping[value[:i]] = field
+			return nil
+		}
+		return fmt.Errorf("cannot import field %q", field)
+	})
+	upsert := cmd.Bool("upsert", false, "update contacts whose external ID exists")
+	var bulkOpts msgmorph.BulkOptions
+	cmd.IntVar(&bulkOpts.BatchSize, "batch-size", msgmorph.DefaultBulkBatchSize, "`number` of contacts sent per batch call")
+	cmd.IntVar(&bulkOpts.Concurrency, "concurrency", msgmorph.DefaultBulkConcurrency, "maximum `number` of calls in flight")
+	cmd.BoolVar(&bulkOpts.DisableBatch, "no-batch", false, "send one request per contact")
+	dryRun := cmd.Bool("dry-run", false, "validate the file without importing it")
+	reqOpts := cmd.idempotencyKeyFlag()
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	if *format == "" {
+		*format = "csv"
+		if ext := strings.ToLower(filepath.Ext(*file)); ext == ".ndjson" || ext == ".jsonl" {
+			*format = "ndjson"
+		}
+	}
+	if *format != "csv" && *format != "ndjson" {
+		return cmd.usageErrorf("unknown file format %q", *format)
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = e.stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var reader *msgmorph.ContactReader
+	if *format == "ndjson" {
+		reader = msgmorph.NewNDJSONContactReader(in, importOpts)
+	} else {
+		reader = msgmorph.NewCSVContactReader(in, importOpts)
+	}
+	inputs, rowErrs, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	report := &importReport{Rows: len(inputs), Invalid: len(rowErrs)}
+	for _, rowErr := range rowErrs {
+		message := rowErr.Err.Error()
+		if rowErr.Field != "" {
+			message = fmt.Sprintf("%s: %s", rowErr.Field, message)
+		}
+		report.Errors = append(report.Errors, importError{
+			Line:    rowErr.Line,
+			Code:    msgmorph.ErrValidationError,
+			Message: message,
+		})
+	}
+
+	if *dryRun {
+		if err := cmd.printer().report(report); err != nil {
+			return err
+		}
+		cmd.infof("Read %d valid and %d invalid rows. Nothing was imported (-dry-run).", report.Rows, report.Invalid)
+		if report.Invalid > 0 {
+			return errPartial
+		}
+		return nil
+	}
+
+	var result *msgmorph.BulkResult
+	var bulkErr error
+	if *upsert {
+		result, bulkErr = client.Contacts.BulkUpsert(ctx, inputs, bulkOpts, reqOpts()...)
+	} else {
+		result, bulkErr = client.Contacts.BulkCreate(ctx, inputs, bulkOpts, reqOpts()...)
+	}
+	if result != nil {
+		report.Created = result.Created
+		report.Updated = result.Updated
+		report.Skipped = result.Skipped
+		report.Failed = result.Failed
+		for _, item := range result.Failures() {
+			report.Errors = append(report.Errors, importError{
+				ExternalID: item.Input.ExternalID,
+				Email:      item.Input.Email,
+				Code:       item.Err.Code,
+				Message:    item.Err.Message,
+			})
+		}
+	}
+
+	if err := cmd.printer().report(report); err != nil {
+		return err
+	}
+	cmd.infof("Read %d valid and %d invalid rows: %d created, %d updated, %d skipped, %d failed.",
+		report.Rows, report.Invalid, report.Created, report.Updated, report.Skipped, report.Failed)
+
+	if bulkErr != nil {
+		return bulkErr
+	}
+	if report.Invalid > 0 || report.Failed > 0 {
+		return errPartial
+	}
+	return nil
+}
+
+// contactsExport implements "contacts export".
+func contactsExport(ctx context.Context, e *env, args []string) error {
+	cmd := newCommand(e, "export", "[-file PATH] [flags]", "Write every contact matching the filters to a CSV or NDJSON file.")
+	params := cmd.listParams()
+	file := cmd.String("file", "-", "`path` of the file to write, or - for standard output")
+	format := cmd.String("format", "csv", "file `format`: csv or ndjson")
+	columns := cmd.String("columns", "", "comma-separated `fields` to export (default all)")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+	if *format != "csv" && *format != "ndjson" {
+		return cmd.usageErrorf("unknown file format %q", *format)
+	}
+
+	var exportOpts msgmorph.ExportOptions
+	if *columns != "" {
+		for _, name := range strings.Split(*columns, ",") {
+			column := msgmorph.ContactField(strings.TrimSpace(name))
+			if !isExportColumn(column) {
+				return cmd.usageErrorf("unknown column %q", column)
+			}
+			exportOpts.Columns = append(exportOpts.Columns, column)
+		}
+	}
+
+	client, err := cmd.client()
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = e.stdout
+	var f *os.File
+	if *file != "-" {
+		f, err = os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if *format == "ndjson" {
+		err = client.Contacts.ExportNDJSON(ctx, out, *params, exportOpts)
+	} else {
+		err = client.Contacts.ExportCSV(ctx, out, *params, exportOpts)
+	}
+	if err != nil {
+		return err
+	}
+	if f != nil {
+		return f.Close()
+	}
+	return nil
+}
+
+// contactInputFlags defines the flags of the contact to create or upsert.
+func contactInputFlags(cmd *command) *msgmorph.CreateContactInput {
+	input := &msgmorph.CreateContactInput{}
+	cmd.StringVar(&input.ExternalID, "external-id", "", "your system's user `ID` (required)")
+	cmd.StringVar(&input.Email, "email", "", "email `address` (required)")
+	cmd.StringVar(&input.Name, "name", "", "display `name`")
+	return input
+}
+
+// isExportColumn reports whether column is a contact field that can be
+// exported.
+func isExportColumn(column msgmorph.ContactField) bool {
+	for _, c := range msgmorph.DefaultExportColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+
+	msgmorph "github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// Exit statuses. See the package documentation.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitConfig      = 3
+	exitAuth        = 4
+	exitNotFound    = 5
+	exitConflict    = 6
+	exitValidation  = 7
+	exitRateLimited = 8
+	exitServer      = 9
+	exitNetwork     = 10
+	exitPartial     = 11
+	exitCanceled    = 130
+)
+
+// usageError reports an invalid command line.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// errPartial reports an import in which some rows were invalid or failed.
+var errPartial = errors.New("some contacts were not imported")
+
+// exitCode returns the exit status for err.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var usageErr *usageError
+	if errors.As(err, &usageErr) || errors.Is(err, errFlags) {
+		return exitUsage
+	}
+	if errors.Is(err, errPartial) {
+		return exitPartial
+	}
+
+	var apiErr *msgmorph.Error
+	if !errors.As(err, &apiErr) {
+		return exitError
+	}
+
+	switch apiErr.Code {
+	case msgmorph.ErrInvalidAPIKey, msgmorph.ErrInvalidOrganizationID, msgmorph.ErrInvalidConfiguration:
+		return exitConfig
+	case msgmorph.ErrUnauthorized, msgmorph.ErrForbidden:
+		return exitAuth
+	case msgmorph.ErrNotFound, msgmorph.ErrRouteNotFound:
+		return exitNotFound
+	case msgmorph.ErrConflict, msgmorph.ErrAlreadyExists:
+		return exitConflict
+	case msgmorph.ErrValidationError, msgmorph.ErrMissingRequiredField:
+		return exitValidation
+	case msgmorph.ErrRateLimited:
+		return exitRateLimited
+	case msgmorph.ErrInternalError, msgmorph.ErrServiceUnavailable:
+		return exitServer
+	case msgmorph.ErrNetworkError, msgmorph.ErrTimeout, msgmorph.ErrDNSError, msgmorph.ErrConnectionRefused, msgmorph.ErrTLSError:
+		return exitNetwork
+	case msgmorph.ErrCanceled:
+		return exitCanceled
+	}
+
+	if apiErr.Status >= 500 {
+		return exitServer
+	}
+	return exitError
+}
@@ -0,0 +1,119 @@
+// Command msgmorph manages MsgMorph contacts from the command line.
+//
+// Usage:
+//
+//	msgmorph contacts <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	create    create a contact
+//	get       get a contact by ID or external ID
+//	list      list contacts
+//	update    update a contact's email or name
+//	delete    delete a contact
+//	upsert    create a contact, or update it if the external ID exists
+//	import    create or upsert contacts from a CSV or NDJSON file
+//	export    write contacts to a CSV or NDJSON file
+//
+// Run "msgmorph contacts <command> -h" for the flags of a command.
+//
+// # Authentication
+//
+// The client is configured as by msgmorph.NewClientFromEnv: from the
+// MSGMORPH_* environment variables and the configuration profile selected by
+// MSGMORPH_PROFILE. The -profile flag selects a profile explicitly, in which
+// case the environment variables are ignored. The -project flag overrides the
+// default project.
+//
+// # Output
+//
+// The -output flag selects the format of the contacts written to standard
+// output: json (the default), table or csv. Messages and errors are written
+// to standard error.
+//
+// # Exit Status
+//
+// The exit status identifies the kind of failure, from the error's code:
+//
+//	0   success
+//	1   unexpected error
+//	2   invalid command line
+//	3   invalid configuration (INVALID_API_KEY, INVALID_ORGANIZATION_ID, INVALID_CONFIGURATION)
+//	4   authentication failed (UNAUTHORIZED, FORBIDDEN)
+//	5   not found (NOT_FOUND, ROUTE_NOT_FOUND)
+//	6   conflict (CONFLICT, ALREADY_EXISTS)
+//	7   invalid input (VALIDATION_ERROR, MISSING_REQUIRED_FIELD)
+//	8   rate limited (RATE_LIMITED)
+//	9   server error (INTERNAL_ERROR, SERVICE_UNAVAILABLE)
+//	10  network error (NETWORK_ERROR, TIMEOUT, DNS_ERROR, CONNECTION_REFUSED, TLS_ERROR)
+//	11  import completed, but some rows were invalid or failed
+//	130 interrupted (CANCELED)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+const usage = `Usage: msgmorph contacts <command> [flags] [arguments]
+
+Commands:
+  create    create a contact
+  get       get a contact by ID or external ID
+  list      list contacts
+  update    update a contact's email or name
+  delete    delete a contact
+  upsert    create a contact, or update it if the external ID exists
+  import    create or upsert contacts from a CSV or NDJSON file
+  export    write contacts to a CSV or NDJSON file
+
+Run "msgmorph contacts <command> -h" for the flags of a command.
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the command line args and returns the exit status.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+
+	if args[0] != "contacts" {
+		fmt.Fprintf(stderr, "msgmorph: unknown command %q\n\n%s", args[0], usage)
+		return exitUsage
+	}
+	if len(args) < 2 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	cmd, ok := contactCommands[args[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "msgmorph: unknown command \"contacts %s\"\n\n%s", args[1], usage)
+		return exitUsage
+	}
+
+	env := &env{stdin: stdin, stdout: stdout, stderr: stderr}
+	err := cmd(ctx, env, args[2:])
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil && !errors.Is(err, errFlags) {
+		fmt.Fprintf(stderr, "msgmorph: %v\n", err)
+	}
+	return exitCode(err)
+}
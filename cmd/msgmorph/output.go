@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	msgmorph "github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// Output formats selected with -output.
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+	formatCSV   = "csv"
+)
+
+// tableColumns are the contact fields shown by table output.
+var tableColumns = []msgmorph.ContactField{
+	msgmorph.ContactFieldID,
+	msgmorph.ContactFieldExternalID,
+	msgmorph.ContactFieldEmail,
+	msgmorph.ContactFieldName,
+	msgmorph.ContactFieldFeedbackSent,
+	msgmorph.ContactFieldCreatedAt,
+}
+
+// tableHeaders are the table output headers of contact fields.
+var tableHeaders = map[msgmorph.ContactField]string{
+	msgmorph.ContactFieldID:                  "ID",
+	msgmorph.ContactFieldExternalID:          "EXTERNAL ID",
+	msgmorph.ContactFieldEmail:               "EMAIL",
+	msgmorph.ContactFieldName:                "NAME",
+	msgmorph.ContactFieldProjectID:           "PROJECT ID",
+	msgmorph.ContactFieldFeedbackSent:        "FEEDBACK SENT",
+	msgmorph.ContactFieldFeedbackScheduledAt: "FEEDBACK SCHEDULED AT",
+	msgmorph.ContactFieldCreatedAt:           "CREATED AT",
+	msgmorph.ContactFieldUpdatedAt:           "UPDATED AT",
+}
+
+// validFormat reports whether format is a supported output format.
+func validFormat(format string) bool {
+	return format == formatJSON || format == formatTable || format == formatCSV
+}
+
+// printer writes results to standard output in the selected format.
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+// contact writes a single contact.
+func (p printer) contact(contact *msgmorph.Contact) error {
+	if p.format == formatJSON {
+		return p.json(contact)
+	}
+	return p.contacts([]msgmorph.Contact{*contact})
+}
+
+// contacts writes a list of contacts. JSON output is an array.
+func (p printer) contacts(contacts []msgmorph.Contact) error {
+	switch p.format {
+	case formatTable:
+		headers := make([]string, len(tableColumns))
+		for i, column := range tableColumns {
+			headers[i] = tableHeaders[column]
+		}
+		rows := make([][]string, len(contacts))
+		for i, contact := range contacts {
+			rows[i] = contactRecord(contact, tableColumns)
+		}
+		return p.table(headers, rows)
+
+	case formatCSV:
+		// The header row uses field names, so the output can be imported.
+		headers := make([]string, len(msgmorph.DefaultExportColumns))
+		for i, column := range msgmorph.DefaultExportColumns {
+			headers[i] = string(column)
+		}
+		rows := make([][]string, len(contacts))
+		for i, contact := range contacts {
+			rows[i] = contactRecord(contact, msgmorph.DefaultExportColumns)
+		}
+		return p.csv(headers, rows)
+
+	default:
+		if contacts == nil {
+			contacts = []msgmorph.Contact{}
+		}
+		return p.json(contacts)
+	}
+}
+
+// report writes the outcome of an import. Table and CSV output list the rows
+// that were not imported.
+func (p printer) report(report *importReport) error {
+	if p.format == formatJSON {
+		return p.json(report)
+	}
+
+	headers := []string{"LINE", "EXTERNAL ID", "EMAIL", "CODE", "MESSAGE"}
+	if p.format == formatCSV {
+		headers = []string{"line", "externalId", "email", "code", "message"}
+	}
+	rows := make([][]string, len(report.Errors))
+	for i, e := range report.Errors {
+		line := ""
+		if e.Line > 0 {
+			line = strconv.Itoa(e.Line)
+		}
+		rows[i] = []string{line, e.ExternalID, e.Email, string(e.Code), e.Message}
+	}
+
+	if p.format == formatCSV {
+		return p.csv(headers, rows)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return p.table(headers, rows)
+}
+
+// json writes v as indented JSON.
+func (p printer) json(v interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// table writes rows as aligned columns.
+func (p printer) table(headers []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// csv writes rows as CSV with a header row.
+func (p printer) csv(headers []string, rows [][]string) error {
+	cw := csv.NewWriter(p.w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// contactRecord formats the given fields of a contact as the ExportCSV
+// method does.
+func contactRecord(contact msgmorph.Contact, columns []msgmorph.ContactField) []string {
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = contact.Field(column)
+	}
+	return record
+}
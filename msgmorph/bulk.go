@@ -0,0 +1,448 @@
+package msgmorph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBulkBatchSize is the default number of contacts sent per batch call.
+const DefaultBulkBatchSize = 100
+
+// DefaultBulkConcurrency is the default number of concurrent calls made by
+// bulk operations.
+const DefaultBulkConcurrency = 4
+
+// BulkOptions configures bulk contact operations.
+type BulkOptions struct {
+	// BatchSize is the number of contacts sent per batch call.
+	// Defaults to DefaultBulkBatchSize.
+	BatchSize int
+
+	// Concurrency is the maximum number of calls in flight at once.
+	// Defaults to DefaultBulkConcurrency.
+	Concurrency int
+
+	// DisableBatch sends one request per contact instead of using the batch
+	// endpoint.
+	DisableBatch bool
+}
+
+// BulkItemStatus is the outcome of a single item in a bulk operation.
+type BulkItemStatus string
+
+// Outcomes of items in a bulk operation.
+const (
+	BulkItemCreated BulkItemStatus = "created"
+	BulkItemUpdated BulkItemStatus = "updated"
+	BulkItemSkipped BulkItemStatus = "skipped"
+	BulkItemFailed  BulkItemStatus = "failed"
+)
+
+// BulkItemResult is the result of a single item in a bulk operation.
+type BulkItemResult struct {
+	// Index is the position of the item in the input.
+	Index int `json:"index"`
+
+	// Input is the item as it was submitted.
+	Input CreateContactInput `json:"input"`
+
+	// Status is the outcome for the item.
+	Status BulkItemStatus `json:"status"`
+
+	// Contact is the created or updated contact. Nil if the item was
+	// skipped or failed.
+	Contact *Contact `json:"contact,omitempty"`
+
+	// Err is the error for a failed item, or the conflict that caused a
+	// duplicate to be skipped.
+	Err *Error `json:"error,omitempty"`
+}
+
+// BulkResult is the report of a bulk operation.
+type BulkResult struct {
+	// Items contains one result per processed item, ordered by Index.
+	Items []BulkItemResult `json:"items"`
+
+	// Created is the number of contacts created.
+	Created int `json:"created"`
+
+	// Updated is the number of existing contacts updated.
+	Updated int `json:"updated"`
+
+	// Skipped is the number of duplicates skipped.
+	Skipped int `json:"skipped"`
+
+	// Failed is the number of items that failed.
+	Failed int `json:"failed"`
+}
+
+// Failures returns the results of the items that failed.
+func (r *BulkResult) Failures() []BulkItemResult {
+	var failures []BulkItemResult
+	for _, item := range r.Items {
+		if item.Status == BulkItemFailed {
+			failures = append(failures, item)
+		}
+	}
+	return failures
+}
+
+// BulkCreate creates many contacts, batching them into as few API calls as
+// possible.
+//
+// Contacts whose ExternalID already exists (ErrAlreadyExists or ErrConflict)
+// are skipped and reported with BulkItemSkipped, whether or not batch calls
+// are used. If the API does not support batch calls, the contacts are created
+// one by one using a bounded pool of workers. Other errors from the batch
+// endpoint, such as an unknown project, fail the batch's items instead.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - inputs: The contacts to create
+//   - opts: Batching and concurrency options
+//   - reqOpts: Optional request options, such as WithRequestTimeout
+//
+// Returns a report with one result per contact. The error is non-nil only if
+// the operation was interrupted, in which case the report covers the items
+// processed so far.
+//
+// Example:
+//
+//	result, err := client.Contacts.BulkCreate(ctx, inputs, msgmorph.BulkOptions{
+//	    BatchSize:   200,
+//	    Concurrency: 8,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Created %d, skipped %d, failed %d\n", result.Created, result.Skipped, result.Failed)
+//	for _, item := range result.Failures() {
+//	    fmt.Printf("Row %d: %s\n", item.Index, item.Err.Message)
+//	}
+func (r *ContactsResource) BulkCreate(ctx context.Context, inputs []CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error) {
+	return r.bulk(ctx, sliceChannel(ctx, inputs), opts, false, reqOpts)
+}
+
+// BulkCreateStream is like BulkCreate, but reads contacts from a channel
+// until it is closed.
+//
+// Example:
+//
+//	inputs := make(chan msgmorph.CreateContactInput)
+//	go func() {
+//	    defer close(inputs)
+//	    for _, u := range users {
+//	        inputs <- msgmorph.CreateContactInput{ExternalID: u.ID, Email: u.Email, ProjectID: projectID}
+//	    }
+//	}()
+//	result, err := client.Contacts.BulkCreateStream(ctx, inputs, msgmorph.BulkOptions{})
+func (r *ContactsResource) BulkCreateStream(ctx context.Context, inputs <-chan CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error) {
+	return r.bulk(ctx, inputs, opts, false, reqOpts)
+}
+
+// BulkUpsert creates or updates many contacts, matching existing contacts by
+// ExternalID as Upsert does.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - inputs: The contacts to create or update
+//   - opts: Batching and concurrency options
+//   - reqOpts: Optional request options, such as WithRequestTimeout
+//
+// Returns a report with one result per contact. The error is non-nil only if
+// the operation was interrupted, in which case the report covers the items
+// processed so far.
+//
+// Example:
+//
+//	result, err := client.Contacts.BulkUpsert(ctx, inputs, msgmorph.BulkOptions{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Created %d, updated %d, failed %d\n", result.Created, result.Updated, result.Failed)
+func (r *ContactsResource) BulkUpsert(ctx context.Context, inputs []CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error) {
+	return r.bulk(ctx, sliceChannel(ctx, inputs), opts, true, reqOpts)
+}
+
+// BulkUpsertStream is like BulkUpsert, but reads contacts from a channel
+// until it is closed.
+func (r *ContactsResource) BulkUpsertStream(ctx context.Context, inputs <-chan CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error) {
+	return r.bulk(ctx, inputs, opts, true, reqOpts)
+}
+
+// bulkItem is an input paired with its position.
+type bulkItem struct {
+	index int
+	input CreateContactInput
+}
+
+// bulkBatchRequest is the request body of the batch endpoint.
+type bulkBatchRequest struct {
+	Contacts []CreateContactInput `json:"contacts"`
+	Upsert   bool                 `json:"upsert,omitempty"`
+}
+
+// bulkBatchResponse is the response body of the batch endpoint.
+type bulkBatchResponse struct {
+	Results []struct {
+		Index   int            `json:"index"`
+		Status  BulkItemStatus `json:"status"`
+		Contact *Contact       `json:"contact"`
+		Error   *Error         `json:"error"`
+	} `json:"results"`
+}
+
+// bulk runs a bulk create or upsert over the items read from inputs.
+//
+// The reqOpts apply to every API call made. An idempotency key set by the
+// caller is suffixed with the batch or item index, so each call has its own.
+func (r *ContactsResource) bulk(ctx context.Context, inputs <-chan CreateContactInput, opts BulkOptions, upsert bool, reqOpts []RequestOption) (*BulkResult, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBulkBatchSize
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var batchSupported atomic.Bool
+	batchSupported.Store(!opts.DisableBatch)
+
+	var (
+		mu      sync.Mutex
+		results []BulkItemResult
+	)
+	record := func(items []BulkItemResult) {
+		mu.Lock()
+		results = append(results, items...)
+		mu.Unlock()
+	}
+
+	batches := make(chan []bulkItem)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				if batchSupported.Load() {
+					items, ok := r.bulkBatch(ctx, batch, upsert, reqOpts)
+					if ok {
+						record(items)
+						continue
+					}
+					batchSupported.Store(false)
+				}
+				for _, item := range batch {
+					if ctx.Err() != nil {
+						break
+					}
+					record([]BulkItemResult{r.bulkSingle(ctx, item, upsert, reqOpts)})
+				}
+			}
+		}()
+	}
+
+	index := 0
+	var batch []bulkItem
+	flush := func() bool {
+		if len(batch) == 0 {
+			return true
+		}
+		select {
+		case batches <- batch:
+			batch = nil
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+read:
+	for {
+		select {
+		case input, ok := <-inputs:
+			if !ok {
+				flush()
+				break read
+			}
+			batch = append(batch, bulkItem{index: index, input: input})
+			index++
+			if len(batch) >= batchSize && !flush() {
+				break read
+			}
+		case <-ctx.Done():
+			break read
+		}
+	}
+	close(batches)
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	report := &BulkResult{Items: results}
+	for _, item := range results {
+		switch item.Status {
+		case BulkItemCreated:
+			report.Created++
+		case BulkItemUpdated:
+			report.Updated++
+		case BulkItemSkipped:
+			report.Skipped++
+		case BulkItemFailed:
+			report.Failed++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return report, newNetworkError(err)
+	}
+	return report, nil
+}
+
+// bulkBatch sends a batch of items to the batch endpoint. Returns false if
+// the API does not support batch calls, in which case no item was processed.
+func (r *ContactsResource) bulkBatch(ctx context.Context, batch []bulkItem, upsert bool, reqOpts []RequestOption) ([]BulkItemResult, bool) {
+	body := bulkBatchRequest{
+		Contacts: make([]CreateContactInput, len(batch)),
+		Upsert:   upsert,
+	}
+	for i, item := range batch {
+		body.Contacts[i] = item.input
+		body.Contacts[i].ProjectID = r.client.projectIDOrDefault(item.input.ProjectID)
+	}
+
+	var resp bulkBatchResponse
+	err := r.client.request(ctx, "contacts.batch", http.MethodPost, "/api/v1/contacts/batch", body, &resp,
+		withSuffixedIdempotencyKey(reqOpts, "batch-"+strconv.Itoa(batch[0].index))...)
+
+	var msgErr *Error
+	if err != nil && errors.As(err, &msgErr) && isUnsupportedEndpoint(msgErr) {
+		return nil, false
+	}
+
+	results := make([]BulkItemResult, len(batch))
+	for i, item := range batch {
+		results[i] = BulkItemResult{Index: item.index, Input: item.input, Status: BulkItemFailed}
+		if err != nil {
+			results[i].Err = toError(err)
+		} else {
+			results[i].Err = newError("No result returned for this item", 0, ErrInternalError, nil)
+		}
+	}
+	if err != nil {
+		return results, true
+	}
+
+	for _, res := range resp.Results {
+		if res.Index < 0 || res.Index >= len(batch) {
+			continue
+		}
+		item := &results[res.Index]
+		item.Status = res.Status
+		item.Contact = res.Contact
+		item.Err = nil
+		if res.Error != nil {
+			item.Err = newError(res.Error.Message, res.Error.Status, res.Error.Code, res.Error.Details)
+			if item.Status == "" {
+				item.Status = BulkItemFailed
+			}
+		}
+		if !upsert && item.Err != nil && isDuplicate(item.Err) {
+			item.Status = BulkItemSkipped
+		}
+	}
+
+	return results, true
+}
+
+// bulkSingle processes one item with a single create or upsert call.
+func (r *ContactsResource) bulkSingle(ctx context.Context, item bulkItem, upsert bool, reqOpts []RequestOption) BulkItemResult {
+	result := BulkItemResult{Index: item.index, Input: item.input}
+	opts := withSuffixedIdempotencyKey(reqOpts, strconv.Itoa(item.index))
+
+	var (
+		contact *Contact
+		created = true
+		err     error
+	)
+	if upsert {
+		contact, created, err = r.Upsert(ctx, item.input, opts...)
+	} else {
+		contact, err = r.Create(ctx, item.input, opts...)
+	}
+
+	switch {
+	case err != nil:
+		result.Err = toError(err)
+		result.Status = BulkItemFailed
+		if !upsert && isDuplicate(result.Err) {
+			result.Status = BulkItemSkipped
+		}
+	case created:
+		result.Contact = contact
+		result.Status = BulkItemCreated
+	default:
+		result.Contact = contact
+		result.Status = BulkItemUpdated
+	}
+
+	return result
+}
+
+// isDuplicate reports whether err means the contact already exists. Such
+// items are skipped by BulkCreate, and updated by Upsert.
+func isDuplicate(err *Error) bool {
+	return err.Code == ErrAlreadyExists || err.Code == ErrConflict
+}
+
+// isUnsupportedEndpoint reports whether err means the API does not provide
+// the called endpoint, as opposed to a resource, such as the project, not
+// being found.
+func isUnsupportedEndpoint(err *Error) bool {
+	switch {
+	case err.Status == http.StatusMethodNotAllowed, err.Code == ErrRouteNotFound:
+		return true
+	case err.Status == http.StatusNotFound:
+		// A missing route is answered without an API error code.
+		return err.inferredCode
+	default:
+		return false
+	}
+}
+
+// sliceChannel returns a channel that yields the items of inputs and is
+// closed once all items are consumed or ctx is done.
+func sliceChannel(ctx context.Context, inputs []CreateContactInput) <-chan CreateContactInput {
+	ch := make(chan CreateContactInput)
+	go func() {
+		defer close(ch)
+		for _, input := range inputs {
+			select {
+			case ch <- input:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// toError converts err to an *Error, wrapping errors of other types.
+func toError(err error) *Error {
+	var msgErr *Error
+	if errors.As(err, &msgErr) {
+		return msgErr
+	}
+	wrapped := newError(err.Error(), 0, ErrInternalError, nil)
+	wrapped.cause = err
+	return wrapped
+}
@@ -43,9 +43,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -56,6 +60,9 @@ const DefaultBaseURL = "https://api.msgmorph.com/"
 // DefaultTimeout is the default HTTP client timeout.
 const DefaultTimeout = 30 * time.Second
 
+// APIKeyPrefix is the prefix of MsgMorph API keys.
+const APIKeyPrefix = "msgmorph_"
+
 // ClientOption is a function that configures a Client.
 type ClientOption func(*Client)
 
@@ -88,6 +95,22 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithProjectID sets the default project ID.
+//
+// The default project is used by calls that take a project ID, such as
+// Contacts.Create and Contacts.List, when none is given.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithProjectID(os.Getenv("MSGMORPH_PROJECT_ID")),
+//	)
+func WithProjectID(projectID string) ClientOption {
+	return func(c *Client) {
+		c.projectID = projectID
+	}
+}
+
 // WithTimeout sets the HTTP client timeout.
 //
 // Example:
@@ -114,6 +137,9 @@ func WithTimeout(timeout time.Duration) ClientOption {
 //
 //	// Create a contact
 //	contact, err := client.Contacts.Create(ctx, input)
+//
+//	// List feedback responses
+//	responses, err := client.Feedback.List(ctx, params)
 type Client struct {
 	// apiKey is the MsgMorph API key for authentication.
 	apiKey string
@@ -121,14 +147,48 @@ type Client struct {
 	// organizationID is the MsgMorph organization ID.
 	organizationID string
 
+	// projectID is the default project ID. Empty means none.
+	projectID string
+
 	// baseURL is the API base URL.
 	baseURL string
 
 	// httpClient is the underlying HTTP client.
 	httpClient *http.Client
 
+	// retryPolicy controls automatic retries of failed requests.
+	retryPolicy RetryPolicy
+
+	// rateLimiter throttles outgoing requests. Nil means unlimited.
+	rateLimiter *rateLimiter
+
+	// optionErrs holds the errors of invalid options, reported by
+	// NewClientE. Invalid options are otherwise ignored.
+	optionErrs []*Error
+
+	// middleware wraps every request, outermost first.
+	middleware []Middleware
+
+	// instrumentation receives tracing and metrics hooks for every call.
+	instrumentation Instrumentation
+
+	// logger receives structured logs of API calls. Nil disables logging.
+	logger *slog.Logger
+
+	// logBodies enables logging of request and response bodies.
+	logBodies bool
+
+	// bodyLogLevel is the level at which bodies are logged.
+	bodyLogLevel slog.Level
+
 	// Contacts provides access to contact management operations.
 	Contacts *ContactsResource
+
+	// Feedback provides access to collected feedback responses.
+	Feedback *FeedbackResource
+
+	// Webhooks provides access to webhook endpoint management operations.
+	Webhooks *WebhooksResource
 }
 
 // NewClient creates a new MsgMorph API client.
@@ -155,25 +215,56 @@ type Client struct {
 //	)
 //
 // Returns a configured Client ready to make API calls.
-// Panics if apiKey or organizationID is empty.
+// Panics if apiKey or organizationID is empty. Use NewClientE to handle
+// configuration errors instead.
 func NewClient(apiKey, organizationID string, opts ...ClientOption) *Client {
 	if apiKey == "" {
-		panic(newError(
-			"API key is required. Set the MSGMORPH_API_KEY environment variable.",
-			400,
-			ErrInvalidAPIKey,
-			nil,
-		))
+		panic(errMissingAPIKey())
 	}
 	if organizationID == "" {
-		panic(newError(
-			"Organization ID is required. Set the MSGMORPH_ORGANIZATION_ID environment variable.",
-			400,
-			ErrInvalidOrganizationID,
-			nil,
-		))
+		panic(errMissingOrganizationID())
+	}
+
+	return newClient(apiKey, organizationID, opts)
+}
+
+// NewClientE creates a new MsgMorph API client, returning an error instead
+// of panicking if the configuration is invalid.
+//
+// In addition to the checks made by NewClient, the API key must start with
+// "msgmorph_" and the base URL must be an absolute http or https URL.
+//
+// Parameters:
+//   - apiKey: Your MsgMorph API key (required)
+//   - organizationID: Your MsgMorph organization ID (required)
+//   - opts: Optional configuration options
+//
+// Returns a configured Client, or an error joining every configuration
+// problem found. Each problem is an *Error.
+//
+// Example:
+//
+//	client, err := msgmorph.NewClientE(apiKey, orgID,
+//	    msgmorph.WithBaseURL(baseURL),
+//	)
+//	if err != nil {
+//	    log.Fatalf("invalid MsgMorph configuration: %v", err)
+//	}
+//
+// Errors:
+//   - ErrInvalidAPIKey: If apiKey is empty or malformed
+//   - ErrInvalidOrganizationID: If organizationID is empty
+//   - ErrInvalidConfiguration: If an option is invalid, such as the base URL
+func NewClientE(apiKey, organizationID string, opts ...ClientOption) (*Client, error) {
+	c := newClient(apiKey, organizationID, opts)
+	if errs := c.validate(); len(errs) > 0 {
+		return nil, joinErrors(errs)
 	}
+	return c, nil
+}
 
+// newClient creates a client without validating its configuration.
+func newClient(apiKey, organizationID string, opts []ClientOption) *Client {
 	c := &Client{
 		apiKey:         apiKey,
 		organizationID: organizationID,
@@ -181,6 +272,7 @@ func NewClient(apiKey, organizationID string, opts ...ClientOption) *Client {
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
+		retryPolicy: noRetryPolicy,
 	}
 
 	// Apply options
@@ -188,58 +280,337 @@ func NewClient(apiKey, organizationID string, opts ...ClientOption) *Client {
 		opt(c)
 	}
 
-	// Initialize resources
+	c.initResources()
+	return c
+}
+
+// errMissingAPIKey returns the error for an empty API key.
+func errMissingAPIKey() *Error {
+	return newError(
+		"API key is required. Set the MSGMORPH_API_KEY environment variable.",
+		400,
+		ErrInvalidAPIKey,
+		nil,
+	)
+}
+
+// errMissingOrganizationID returns the error for an empty organization ID.
+func errMissingOrganizationID() *Error {
+	return newError(
+		"Organization ID is required. Set the MSGMORPH_ORGANIZATION_ID environment variable.",
+		400,
+		ErrInvalidOrganizationID,
+		nil,
+	)
+}
+
+// validate checks the client's configuration and returns every problem
+// found.
+func (c *Client) validate() []*Error {
+	var errs []*Error
+
+	switch {
+	case c.apiKey == "":
+		errs = append(errs, errMissingAPIKey())
+	case !strings.HasPrefix(c.apiKey, APIKeyPrefix):
+		errs = append(errs, newError(
+			fmt.Sprintf("API key must start with %q.", APIKeyPrefix),
+			400,
+			ErrInvalidAPIKey,
+			nil,
+		))
+	}
+
+	if c.organizationID == "" {
+		errs = append(errs, errMissingOrganizationID())
+	}
+
+	u, err := url.Parse(c.baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, newError(
+			fmt.Sprintf("Base URL %q must be an absolute http or https URL.", c.baseURL),
+			400,
+			ErrInvalidConfiguration,
+			nil,
+		))
+	}
+
+	return append(errs, c.optionErrs...)
+}
+
+// joinErrors joins errs into a single error.
+func joinErrors(errs []*Error) error {
+	joined := make([]error, len(errs))
+	for i, err := range errs {
+		joined[i] = err
+	}
+	return errors.Join(joined...)
+}
+
+// initResources initializes the client's resources.
+func (c *Client) initResources() {
 	c.Contacts = &ContactsResource{client: c}
+	c.Feedback = &FeedbackResource{client: c}
+	c.Webhooks = &WebhooksResource{client: c}
+}
 
-	return c
+// OrganizationID returns the organization ID the client acts for.
+func (c *Client) OrganizationID() string {
+	return c.organizationID
+}
+
+// ProjectID returns the client's default project ID, or an empty string if
+// it has none.
+func (c *Client) ProjectID() string {
+	return c.projectID
+}
+
+// WithOrganization returns a copy of the client that acts for another
+// organization.
+//
+// The copy is cheap to create and shares the client's HTTP transport, rate
+// limiter, middleware and other settings, so a single client can serve many
+// organizations. The default project ID is cleared, since projects belong to
+// an organization.
+//
+// Example:
+//
+//	for _, tenant := range tenants {
+//	    tenantClient := client.WithOrganization(tenant.OrganizationID)
+//	    contacts, err := tenantClient.Contacts.List(ctx, msgmorph.ListContactsParams{
+//	        ProjectID: tenant.ProjectID,
+//	    })
+//	    // ...
+//	}
+//
+// An empty organizationID inherits the client's organization, and the copy
+// keeps the default project ID.
+func (c *Client) WithOrganization(organizationID string) *Client {
+	clone := c.clone()
+	if organizationID == "" {
+		return clone
+	}
+
+	clone.organizationID = organizationID
+	clone.projectID = ""
+	return clone
+}
+
+// ForProject returns a copy of the client whose default project is
+// projectID. Calls that take a project ID, such as Contacts.Create and
+// Contacts.List, use it when none is given.
+//
+// Like WithOrganization, the copy is cheap to create and shares the client's
+// HTTP transport and settings. An empty projectID clears the default project,
+// so calls must then name one.
+//
+// Example:
+//
+//	project := client.ForProject("proj-456")
+//
+//	contact, err := project.Contacts.Create(ctx, msgmorph.CreateContactInput{
+//	    ExternalID: "user-123",
+//	    Email:      "user@example.com",
+//	})
+//
+//	contacts, err := project.Contacts.List(ctx, msgmorph.ListContactsParams{})
+func (c *Client) ForProject(projectID string) *Client {
+	clone := c.clone()
+	clone.projectID = projectID
+	return clone
+}
+
+// clone returns a shallow copy of the client with its own resources.
+func (c *Client) clone() *Client {
+	clone := *c
+	clone.initResources()
+	return &clone
+}
+
+// projectIDOrDefault returns projectID, or the client's default project ID
+// if projectID is empty.
+func (c *Client) projectIDOrDefault(projectID string) string {
+	if projectID == "" {
+		return c.projectID
+	}
+	return projectID
 }
 
 // request makes an authenticated HTTP request to the MsgMorph API.
 // This is an internal method used by resource methods.
-func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	url := c.baseURL + path
+//
+// The operation names the SDK call, such as "contacts.create", for
+// instrumentation. The opts override the client's settings for this call.
+// The request passes through the client's middleware chain before being sent
+// by roundTrip. On success, the response body is decoded into result.
+func (c *Client) request(ctx context.Context, operation, method, path string, body interface{}, result interface{}, opts ...RequestOption) error {
+	req := &Request{
+		Operation: operation,
+		Method:    method,
+		Path:      path,
+		Body:      body,
+		Header:    make(http.Header),
+	}
 
-	var reqBody io.Reader
-	if body != nil && method != http.MethodGet {
-		jsonBody, err := json.Marshal(body)
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("X-Organization-Id", c.organizationID)
+
+	ctx, cancel := newRequestConfig(opts).apply(ctx, c, req)
+	defer cancel()
+
+	if c.instrumentation == nil {
+		_, err := c.call(ctx, req, result)
+		return err
+	}
+
+	ctx, finish := c.instrumentation.StartCall(ctx, req)
+	start := time.Now()
+	resp, err := c.call(ctx, req, result)
+	finish(newCallResult(resp, err, time.Since(start)))
+	return err
+}
+
+// call runs req through the middleware chain and decodes the response into
+// result. The response is returned along with any error.
+func (c *Client) call(ctx context.Context, req *Request, result interface{}) (*Response, error) {
+	handler := RoundTripFunc(c.roundTrip)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if result != nil && resp != nil && len(resp.Body) > 0 {
+		if err := json.Unmarshal(resp.Body, result); err != nil {
+			return resp, newError(fmt.Sprintf("failed to parse response: %v", err), resp.StatusCode, ErrInternalError, nil)
+		}
+	}
+
+	return resp, nil
+}
+
+// roundTrip sends req to the API, retrying failed attempts according to the
+// client's retry policy. The returned *Error records how many attempts were
+// made and the idempotency key sent, if any.
+func (c *Client) roundTrip(ctx context.Context, req *Request) (*Response, error) {
+	start := time.Now()
+	resp, attempts, apiErr := c.send(ctx, req)
+	c.logRequest(ctx, req, attempts, resp, apiErr, time.Since(start))
+	if resp != nil {
+		resp.attempts = attempts
+	}
+
+	if apiErr != nil {
+		apiErr.IdempotencyKey = req.Header.Get(IdempotencyKeyHeader)
+		return resp, apiErr
+	}
+	return resp, nil
+}
+
+// send performs the attempts for req and returns the last response, the
+// number of attempts made, and the final error, if any.
+func (c *Client) send(ctx context.Context, req *Request) (*Response, int, *Error) {
+	url := req.baseURL + req.Path
+
+	var jsonBody []byte
+	if req.Body != nil && req.Method != http.MethodGet {
+		var err error
+		jsonBody, err = json.Marshal(req.Body)
 		if err != nil {
-			return newError(fmt.Sprintf("failed to marshal request body: %v", err), 0, ErrValidationError, nil)
+			marshalErr := newError(fmt.Sprintf("failed to marshal request body: %v", err), 0, ErrValidationError, nil)
+			marshalErr.cause = err
+			return nil, 0, marshalErr
+		}
+	}
+
+	policy := req.retryPolicy
+	idempotent := isIdempotent(req.Method) || req.Header.Get(IdempotencyKeyHeader) != ""
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.wait(ctx, req.Method, req.Path); err != nil {
+				waitErr := newNetworkError(err)
+				waitErr.Attempts = attempt - 1
+				return nil, attempt - 1, waitErr
+			}
+		}
+
+		c.logBody(ctx, req, "msgmorph: request body", jsonBody)
+		start := time.Now()
+		resp, apiErr := c.do(ctx, req.Method, url, req.Header, jsonBody)
+		c.logAttempt(ctx, req, attempt, resp, apiErr, time.Since(start))
+		if resp != nil {
+			c.logBody(ctx, req, "msgmorph: response body", resp.Body)
 		}
+
+		if apiErr == nil {
+			return resp, attempt, nil
+		}
+		apiErr.Attempts = attempt
+
+		// Retrying is pointless once the context is done.
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil || !policy.shouldRetry(idempotent, apiErr) {
+			return resp, attempt, apiErr
+		}
+
+		if err := sleepContext(ctx, policy.delay(attempt, apiErr)); err != nil {
+			ctxErr := newNetworkError(err)
+			ctxErr.Attempts = attempt
+			return resp, attempt, ctxErr
+		}
+	}
+}
+
+// do performs a single HTTP request attempt. The response is non-nil if the
+// server responded, even with an error status.
+func (c *Client) do(ctx context.Context, method, url string, header http.Header, jsonBody []byte) (*Response, *Error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
 		reqBody = bytes.NewReader(jsonBody)
 	}
 
+	// A request that cannot be built is a configuration problem, such as a
+	// malformed base URL, rather than a transport failure, and retrying it
+	// cannot help.
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return newNetworkError(err)
+		return nil, newRequestError(err)
 	}
-
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.apiKey)
-	req.Header.Set("X-Organization-Id", c.organizationID)
+	if (req.URL.Scheme != "http" && req.URL.Scheme != "https") || req.URL.Host == "" {
+		return nil, newRequestError(fmt.Errorf("request URL %q must be an absolute http or https URL", url))
+	}
+	req.Header = header.Clone()
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return newNetworkError(err)
+		return nil, newNetworkError(err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return newNetworkError(err)
+		return nil, newNetworkError(err)
 	}
 
-	if resp.StatusCode >= 400 {
-		return parseErrorResponse(respBody, resp.StatusCode)
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return newError(fmt.Sprintf("failed to parse response: %v", err), resp.StatusCode, ErrInternalError, nil)
-		}
+	if resp.StatusCode >= 400 {
+		apiErr := parseErrorResponse(respBody, resp.StatusCode)
+		now := time.Now()
+		apiErr.RetryAfter = parseRetryAfter(resp.Header, now)
+		apiErr.RateLimit = parseRateLimit(resp.Header, now)
+		return response, apiErr
 	}
 
-	return nil
+	return response, nil
 }
 
 // parseErrorResponse parses an error response from the API.
@@ -252,7 +623,9 @@ func parseErrorResponse(body []byte, status int) *Error {
 	}
 
 	if err := json.Unmarshal(body, &errResp); err != nil {
-		return newError("An unexpected error occurred", status, errorCodeFromStatus(status), nil)
+		apiErr := newError("An unexpected error occurred", status, errorCodeFromStatus(status), nil)
+		apiErr.inferredCode = true
+		return apiErr
 	}
 
 	message := errResp.Message
@@ -264,9 +637,12 @@ func parseErrorResponse(body []byte, status int) *Error {
 	}
 
 	code := ErrorCode(errResp.Code)
-	if code == "" {
+	inferred := code == ""
+	if inferred {
 		code = errorCodeFromStatus(status)
 	}
 
-	return newError(message, status, code, errResp.Details)
+	apiErr := newError(message, status, code, errResp.Details)
+	apiErr.inferredCode = inferred
+	return apiErr
 }
@@ -2,8 +2,11 @@ package msgmorph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // ContactsResource provides methods to manage contacts in MsgMorph.
@@ -25,6 +28,13 @@ import (
 //	    ProjectID: "proj-456",
 //	})
 //
+//	// Iterate over every contact, page by page
+//	for contact, err := range client.Contacts.All(ctx, msgmorph.ListContactsParams{
+//	    ProjectID: "proj-456",
+//	}) {
+//	    // ...
+//	}
+//
 //	// Get a contact
 //	contact, err := client.Contacts.Get(ctx, "contact-id")
 //
@@ -35,6 +45,9 @@ import (
 //
 //	// Delete a contact
 //	err = client.Contacts.Delete(ctx, "contact-id")
+//
+//	// Schedule a feedback request for a week from now
+//	contact, err = client.Contacts.ScheduleFeedback(ctx, "contact-id", time.Now().AddDate(0, 0, 7))
 type ContactsResource struct {
 	client *Client
 }
@@ -47,6 +60,7 @@ type ContactsResource struct {
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - input: Contact creation parameters
+//   - opts: Optional request options, such as WithIdempotencyKey
 //
 // Returns the created Contact or an error.
 //
@@ -67,20 +81,26 @@ type ContactsResource struct {
 //   - ErrValidationError: If required fields are missing
 //   - ErrAlreadyExists: If a contact with the same externalId already exists
 //   - ErrUnauthorized: If the API key is invalid
-func (r *ContactsResource) Create(ctx context.Context, input CreateContactInput) (*Contact, error) {
+func (r *ContactsResource) Create(ctx context.Context, input CreateContactInput, opts ...RequestOption) (*Contact, error) {
+	input.ProjectID = r.client.projectIDOrDefault(input.ProjectID)
+
 	var contact Contact
-	err := r.client.request(ctx, http.MethodPost, "/api/v1/contacts", input, &contact)
+	err := r.client.request(ctx, "contacts.create", http.MethodPost, "/api/v1/contacts", input, &contact, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contact, nil
 }
 
-// List retrieves all contacts for a project.
+// List retrieves a single page of contacts for a project.
+//
+// Use ListPage to access pagination details, or All to iterate over every
+// contact in the project.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - params: Query parameters for filtering contacts
+//   - opts: Optional request options, such as WithRequestTimeout
 //
 // Returns a slice of Contact objects or an error.
 //
@@ -99,15 +119,85 @@ func (r *ContactsResource) Create(ctx context.Context, input CreateContactInput)
 // Errors:
 //   - ErrValidationError: If projectId is missing
 //   - ErrUnauthorized: If the API key is invalid
-func (r *ContactsResource) List(ctx context.Context, params ListContactsParams) ([]Contact, error) {
-	path := fmt.Sprintf("/api/v1/contacts?projectId=%s", params.ProjectID)
+func (r *ContactsResource) List(ctx context.Context, params ListContactsParams, opts ...RequestOption) ([]Contact, error) {
+	page, err := r.ListPage(ctx, params, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page.Data, nil
+}
+
+// ListPage retrieves a single page of contacts along with pagination details.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - params: Query parameters for filtering, sorting and paginating contacts
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns a ContactPage or an error.
+//
+// Example:
+//
+//	params := msgmorph.ListContactsParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	    Limit:     100,
+//	}
+//	for {
+//	    page, err := client.Contacts.ListPage(ctx, params)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    for _, c := range page.Data {
+//	        fmt.Printf("Contact: %s\n", c.Email)
+//	    }
+//	    if !page.HasMore {
+//	        break
+//	    }
+//	    params.Cursor = page.NextCursor
+//	}
+//
+// Errors:
+//   - ErrValidationError: If projectId is missing or a parameter is invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) ListPage(ctx context.Context, params ListContactsParams, opts ...RequestOption) (*ContactPage, error) {
+	params.ProjectID = r.client.projectIDOrDefault(params.ProjectID)
+
+	path, err := withQuery("/api/v1/contacts", params)
+	if err != nil {
+		return nil, err
+	}
 
-	var contacts []Contact
-	err := r.client.request(ctx, http.MethodGet, path, nil, &contacts)
+	var page ContactPage
+	err = r.client.request(ctx, "contacts.list", http.MethodGet, path, nil, &page, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return contacts, nil
+	return &page, nil
+}
+
+// All returns an iterator over every contact matching params.
+//
+// Pages are fetched lazily as the iteration progresses, starting from
+// params.Cursor or params.Offset. If a page cannot be fetched, the error is
+// yielded and the iteration stops.
+//
+// Example:
+//
+//	for contact, err := range client.Contacts.All(ctx, msgmorph.ListContactsParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	    Limit:     500,
+//	}) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Printf("Contact: %s\n", contact.Email)
+//	}
+func (r *ContactsResource) All(ctx context.Context, params ListContactsParams, opts ...RequestOption) iter.Seq2[Contact, error] {
+	return paginate(params.Cursor, params.Offset, func(cursor string, offset int) (*ContactPage, error) {
+		params.Cursor = cursor
+		params.Offset = offset
+		return r.ListPage(ctx, params, opts...)
+	})
 }
 
 // Get retrieves a single contact by ID.
@@ -115,6 +205,7 @@ func (r *ContactsResource) List(ctx context.Context, params ListContactsParams)
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - id: The contact's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithRequestTimeout
 //
 // Returns the Contact or an error.
 //
@@ -134,17 +225,69 @@ func (r *ContactsResource) List(ctx context.Context, params ListContactsParams)
 // Errors:
 //   - ErrNotFound: If the contact doesn't exist
 //   - ErrUnauthorized: If the API key is invalid
-func (r *ContactsResource) Get(ctx context.Context, id string) (*Contact, error) {
-	path := fmt.Sprintf("/api/v1/contacts/%s", id)
+func (r *ContactsResource) Get(ctx context.Context, id string, opts ...RequestOption) (*Contact, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s", segment)
 
 	var contact Contact
-	err := r.client.request(ctx, http.MethodGet, path, nil, &contact)
+	err = r.client.request(ctx, "contacts.get", http.MethodGet, path, nil, &contact, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contact, nil
 }
 
+// GetByExternalID retrieves a contact by your system's user ID.
+//
+// The contacts are listed filtered by external ID. If the server does not
+// apply the filter, GetByExternalID pages through the project's contacts
+// until one matches, so an existing contact is never reported as not found.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - projectID: The MsgMorph project ID the contact belongs to. Empty uses
+//     the client's default project.
+//   - externalID: Your system's user ID for the contact
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns the Contact or an error.
+//
+// Example:
+//
+//	contact, err := client.Contacts.GetByExternalID(ctx, os.Getenv("MSGMORPH_PROJECT_ID"), "user-123")
+//	if err != nil {
+//	    var msgErr *msgmorph.Error
+//	    if errors.As(err, &msgErr) && msgErr.IsNotFound() {
+//	        fmt.Println("Contact not found")
+//	        return
+//	    }
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Contact: %s\n", contact.ID)
+//
+// Errors:
+//   - ErrNotFound: If no contact has the given external ID
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) GetByExternalID(ctx context.Context, projectID, externalID string, opts ...RequestOption) (*Contact, error) {
+	params := ListContactsParams{
+		ProjectID:  projectID,
+		ExternalID: externalID,
+	}
+	for contact, err := range r.All(ctx, params, opts...) {
+		if err != nil {
+			return nil, err
+		}
+		if contact.ExternalID == externalID {
+			return &contact, nil
+		}
+	}
+
+	return nil, newError(fmt.Sprintf("Contact with external ID %q not found", externalID), http.StatusNotFound, ErrNotFound, nil)
+}
+
 // Update modifies an existing contact.
 //
 // Only the fields provided in the input will be updated.
@@ -154,6 +297,7 @@ func (r *ContactsResource) Get(ctx context.Context, id string) (*Contact, error)
 //   - ctx: Context for cancellation and timeout control
 //   - id: The contact's unique ID in MsgMorph
 //   - input: Fields to update
+//   - opts: Optional request options, such as WithIdempotencyKey
 //
 // Returns the updated Contact or an error.
 //
@@ -172,17 +316,85 @@ func (r *ContactsResource) Get(ctx context.Context, id string) (*Contact, error)
 //   - ErrNotFound: If the contact doesn't exist
 //   - ErrValidationError: If the input is invalid
 //   - ErrUnauthorized: If the API key is invalid
-func (r *ContactsResource) Update(ctx context.Context, id string, input UpdateContactInput) (*Contact, error) {
-	path := fmt.Sprintf("/api/v1/contacts/%s", id)
+func (r *ContactsResource) Update(ctx context.Context, id string, input UpdateContactInput, opts ...RequestOption) (*Contact, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s", segment)
 
 	var contact Contact
-	err := r.client.request(ctx, http.MethodPatch, path, input, &contact)
+	err = r.client.request(ctx, "contacts.update", http.MethodPatch, path, input, &contact, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contact, nil
 }
 
+// Upsert creates a contact, or updates the existing contact with the same
+// ExternalID in the project.
+//
+// If a contact with input.ExternalID already exists, its Email and Name are
+// updated from input. The returned bool reports whether a new contact was
+// created (true) or an existing one was updated (false).
+//
+// An idempotency key set with WithIdempotencyKey is sent with the create
+// request; the follow-up update, if any, uses the key suffixed with ":update".
+// The lookup of the existing contact is sent without a key.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - input: Contact parameters, as for Create
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the created or updated Contact, whether it was created, or an error.
+//
+// Example:
+//
+//	contact, created, err := client.Contacts.Upsert(ctx, msgmorph.CreateContactInput{
+//	    ExternalID: "user-123",
+//	    Email:      "alice@example.com",
+//	    Name:       "Alice Smith",
+//	    ProjectID:  os.Getenv("MSGMORPH_PROJECT_ID"),
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if created {
+//	    fmt.Printf("Created contact: %s\n", contact.ID)
+//	} else {
+//	    fmt.Printf("Updated contact: %s\n", contact.ID)
+//	}
+//
+// Errors:
+//   - ErrValidationError: If required fields are missing
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) Upsert(ctx context.Context, input CreateContactInput, opts ...RequestOption) (*Contact, bool, error) {
+	contact, err := r.Create(ctx, input, opts...)
+	if err == nil {
+		return contact, true, nil
+	}
+
+	var msgErr *Error
+	if !errors.As(err, &msgErr) || !isDuplicate(msgErr) {
+		return nil, false, err
+	}
+
+	existing, err := r.GetByExternalID(ctx, input.ProjectID, input.ExternalID, withoutIdempotencyKey(opts)...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	contact, err = r.Update(ctx, existing.ID, UpdateContactInput{
+		Email: input.Email,
+		Name:  input.Name,
+	}, withSuffixedIdempotencyKey(opts, "update")...)
+	if err != nil {
+		return nil, false, err
+	}
+	return contact, false, nil
+}
+
 // Delete removes a contact.
 //
 // This operation is permanent and cannot be undone.
@@ -190,6 +402,7 @@ func (r *ContactsResource) Update(ctx context.Context, id string, input UpdateCo
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - id: The contact's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithIdempotencyKey
 //
 // Returns nil on success or an error.
 //
@@ -209,7 +422,125 @@ func (r *ContactsResource) Update(ctx context.Context, id string, input UpdateCo
 // Errors:
 //   - ErrNotFound: If the contact doesn't exist
 //   - ErrUnauthorized: If the API key is invalid
-func (r *ContactsResource) Delete(ctx context.Context, id string) error {
-	path := fmt.Sprintf("/api/v1/contacts/%s", id)
-	return r.client.request(ctx, http.MethodDelete, path, nil, nil)
+func (r *ContactsResource) Delete(ctx context.Context, id string, opts ...RequestOption) error {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s", segment)
+	return r.client.request(ctx, "contacts.delete", http.MethodDelete, path, nil, nil, opts...)
+}
+
+// SendFeedbackRequest sends a feedback request to a contact immediately.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The contact's unique ID in MsgMorph
+//   - input: Options for the feedback request
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the updated Contact, with FeedbackSent set, or an error.
+//
+// Example:
+//
+//	contact, err := client.Contacts.SendFeedbackRequest(ctx, "cnt_abc123", msgmorph.SendFeedbackRequestInput{
+//	    Channel: msgmorph.FeedbackChannelEmail,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Feedback sent: %t\n", contact.FeedbackSent)
+//
+// Errors:
+//   - ErrNotFound: If the contact doesn't exist
+//   - ErrConflict: If feedback has already been sent to the contact
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) SendFeedbackRequest(ctx context.Context, id string, input SendFeedbackRequestInput, opts ...RequestOption) (*Contact, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s/feedback-request", segment)
+
+	var contact Contact
+	err = r.client.request(ctx, "contacts.send_feedback_request", http.MethodPost, path, input, &contact, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// ScheduleFeedback schedules a feedback request to be sent to a contact at
+// the given time.
+//
+// Calling ScheduleFeedback for a contact that already has a scheduled
+// request reschedules it.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The contact's unique ID in MsgMorph
+//   - at: When the feedback request should be sent
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the updated Contact, with FeedbackScheduledAt set, or an error.
+//
+// Example:
+//
+//	contact, err := client.Contacts.ScheduleFeedback(ctx, "cnt_abc123", time.Now().AddDate(0, 0, 7))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Feedback scheduled at: %s\n", contact.FeedbackScheduledAt)
+//
+// Errors:
+//   - ErrNotFound: If the contact doesn't exist
+//   - ErrValidationError: If the time is in the past
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) ScheduleFeedback(ctx context.Context, id string, at time.Time, opts ...RequestOption) (*Contact, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s/feedback-schedule", segment)
+
+	var contact Contact
+	err = r.client.request(ctx, "contacts.schedule_feedback", http.MethodPut, path, scheduleFeedbackInput{ScheduledAt: at}, &contact, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// CancelScheduledFeedback cancels a contact's scheduled feedback request.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The contact's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the updated Contact, with FeedbackScheduledAt cleared, or an error.
+//
+// Example:
+//
+//	contact, err := client.Contacts.CancelScheduledFeedback(ctx, "cnt_abc123")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Errors:
+//   - ErrNotFound: If the contact doesn't exist or has no scheduled request
+//   - ErrUnauthorized: If the API key is invalid
+func (r *ContactsResource) CancelScheduledFeedback(ctx context.Context, id string, opts ...RequestOption) (*Contact, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/contacts/%s/feedback-schedule", segment)
+
+	var contact Contact
+	err = r.client.request(ctx, "contacts.cancel_scheduled_feedback", http.MethodDelete, path, nil, &contact, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &contact, nil
 }
@@ -0,0 +1,474 @@
+package msgmorph
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContactField identifies a contact field in imported and exported files.
+type ContactField string
+
+// Contact fields that can be imported and exported.
+const (
+	ContactFieldID                  ContactField = "id"
+	ContactFieldExternalID          ContactField = "externalId"
+	ContactFieldEmail               ContactField = "email"
+	ContactFieldName                ContactField = "name"
+	ContactFieldProjectID           ContactField = "projectId"
+	ContactFieldFeedbackSent        ContactField = "feedbackSent"
+	ContactFieldFeedbackScheduledAt ContactField = "feedbackScheduledAt"
+	ContactFieldCreatedAt           ContactField = "createdAt"
+	ContactFieldUpdatedAt           ContactField = "updatedAt"
+)
+
+// DefaultExportColumns are the columns exported when none are specified.
+var DefaultExportColumns = []ContactField{
+	ContactFieldID,
+	ContactFieldExternalID,
+	ContactFieldEmail,
+	ContactFieldName,
+	ContactFieldProjectID,
+	ContactFieldFeedbackSent,
+	ContactFieldFeedbackScheduledAt,
+	ContactFieldCreatedAt,
+	ContactFieldUpdatedAt,
+}
+
+// importFields are the fields read into a CreateContactInput.
+var importFields = []ContactField{
+	ContactFieldExternalID,
+	ContactFieldEmail,
+	ContactFieldName,
+	ContactFieldProjectID,
+}
+
+// ImportOptions configures how contacts are read from CSV or NDJSON.
+type ImportOptions struct {
+	// HeaderMapping maps CSV column headers (or NDJSON keys) to contact
+	// fields, for example {"User ID": ContactFieldExternalID}.
+	// Headers that are not mapped are matched case-insensitively against
+	// the field names, such as "externalId" or "email".
+	HeaderMapping map[string]ContactField
+
+	// ProjectID is used for rows that do not specify a project ID.
+	// If it is empty too, the ProjectID of those contacts is left empty, so
+	// that Create and the bulk methods use the client's default project.
+	ProjectID string
+}
+
+// RowError reports an invalid row in an imported file.
+//
+// A RowError does not stop the import; call Read again to continue with the
+// next row.
+type RowError struct {
+	// Line is the 1-based line number of the row in the input.
+	Line int
+
+	// Field is the field that failed validation, if any.
+	Field ContactField
+
+	// Err describes the problem.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RowError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("line %d: %s: %v", e.Line, e.Field, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// ContactReader reads contacts to import from CSV or NDJSON input.
+//
+// Example:
+//
+//	f, err := os.Open("contacts.csv")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	reader := msgmorph.NewCSVContactReader(f, msgmorph.ImportOptions{
+//	    HeaderMapping: map[string]msgmorph.ContactField{
+//	        "User ID":       msgmorph.ContactFieldExternalID,
+//	        "Email Address": msgmorph.ContactFieldEmail,
+//	    },
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	})
+//	inputs, rowErrs, err := reader.ReadAll()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, rowErr := range rowErrs {
+//	    fmt.Println(rowErr)
+//	}
+//	result, err := client.Contacts.BulkUpsert(ctx, inputs, msgmorph.BulkOptions{})
+type ContactReader struct {
+	opts ImportOptions
+	next func() (map[ContactField]string, int, error)
+}
+
+// NewCSVContactReader returns a ContactReader that reads CSV input.
+// The first row must contain the column headers. A leading UTF-8 byte order
+// mark, as written by Excel and Google Sheets, is ignored.
+func NewCSVContactReader(r io.Reader, opts ImportOptions) *ContactReader {
+	cr := csv.NewReader(skipBOM(r))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var columns []ContactField
+	next := func() (map[ContactField]string, int, error) {
+		if columns == nil {
+			header, err := cr.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil, 0, io.EOF
+				}
+				return nil, 0, err
+			}
+			columns = make([]ContactField, len(header))
+			for i, h := range header {
+				columns[i] = opts.fieldFor(h)
+			}
+			for _, required := range []ContactField{ContactFieldExternalID, ContactFieldEmail} {
+				if !containsField(columns, required) {
+					return nil, 1, fmt.Errorf("missing required column for %s", required)
+				}
+			}
+		}
+
+		record, err := cr.Read()
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, parseErr.StartLine, &RowError{Line: parseErr.StartLine, Err: parseErr.Err}
+			}
+			return nil, 0, err
+		}
+		line, _ := cr.FieldPos(0)
+
+		row := make(map[ContactField]string, len(record))
+		for i, value := range record {
+			if i < len(columns) && columns[i] != "" {
+				row[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		return row, line, nil
+	}
+
+	return &ContactReader{opts: opts, next: next}
+}
+
+// skipBOM returns a reader that skips a leading UTF-8 byte order mark in r.
+// The mark is skipped before parsing, so that it cannot become part of the
+// first header, quoted or not.
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && string(bom) == "\ufeff" {
+		br.Discard(3)
+	}
+	return br
+}
+
+// NewNDJSONContactReader returns a ContactReader that reads newline-delimited
+// JSON input, one contact object per line. Blank lines are skipped.
+//
+// Values must be strings, numbers or null. Numbers are read exactly as
+// written, so a numeric "externalId": 12345678 is imported as "12345678".
+func NewNDJSONContactReader(r io.Reader, opts ImportOptions) *ContactReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	line := 0
+	next := func() (map[ContactField]string, int, error) {
+		for scanner.Scan() {
+			line++
+			data := bytes.TrimSpace(scanner.Bytes())
+			if len(data) == 0 {
+				continue
+			}
+
+			var obj map[string]interface{}
+			dec := json.NewDecoder(bytes.NewReader(data))
+			dec.UseNumber()
+			if err := dec.Decode(&obj); err != nil {
+				return nil, line, &RowError{Line: line, Err: err}
+			}
+			if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+				return nil, line, &RowError{Line: line, Err: errors.New("unexpected data after JSON object")}
+			}
+
+			row := make(map[ContactField]string, len(obj))
+			for key, value := range obj {
+				field := opts.fieldFor(key)
+				if field == "" {
+					continue
+				}
+				switch value := value.(type) {
+				case nil:
+				case string:
+					row[field] = strings.TrimSpace(value)
+				case json.Number:
+					row[field] = value.String()
+				default:
+					return nil, line, &RowError{Line: line, Field: field, Err: errors.New("must be a string or number")}
+				}
+			}
+			return row, line, nil
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, line, err
+		}
+		return nil, line, io.EOF
+	}
+
+	return &ContactReader{opts: opts, next: next}
+}
+
+// Read returns the next contact.
+//
+// It returns io.EOF when the input is exhausted, and a *RowError for an
+// invalid row, after which reading can continue. Any other error is fatal.
+func (r *ContactReader) Read() (CreateContactInput, error) {
+	row, line, err := r.next()
+	if err != nil {
+		return CreateContactInput{}, err
+	}
+
+	input := CreateContactInput{
+		ExternalID: row[ContactFieldExternalID],
+		Email:      row[ContactFieldEmail],
+		Name:       row[ContactFieldName],
+		ProjectID:  row[ContactFieldProjectID],
+	}
+	if input.ProjectID == "" {
+		input.ProjectID = r.opts.ProjectID
+	}
+
+	switch {
+	case input.ExternalID == "":
+		return input, &RowError{Line: line, Field: ContactFieldExternalID, Err: errors.New("is required")}
+	case input.Email == "":
+		return input, &RowError{Line: line, Field: ContactFieldEmail, Err: errors.New("is required")}
+	}
+	if _, err := mail.ParseAddress(input.Email); err != nil {
+		return input, &RowError{Line: line, Field: ContactFieldEmail, Err: fmt.Errorf("invalid email address %q", input.Email)}
+	}
+
+	return input, nil
+}
+
+// ReadAll reads all remaining contacts, collecting invalid rows separately.
+//
+// Returns the valid contacts, the errors for invalid rows, and a fatal error
+// if the input could not be read.
+func (r *ContactReader) ReadAll() ([]CreateContactInput, []*RowError, error) {
+	var (
+		inputs  []CreateContactInput
+		rowErrs []*RowError
+	)
+	for {
+		input, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return inputs, rowErrs, nil
+		}
+
+		var rowErr *RowError
+		switch {
+		case errors.As(err, &rowErr):
+			rowErrs = append(rowErrs, rowErr)
+		case err != nil:
+			return inputs, rowErrs, err
+		default:
+			inputs = append(inputs, input)
+		}
+	}
+}
+
+// fieldFor returns the contact field for a column header, or "" if the
+// column is not imported.
+func (o ImportOptions) fieldFor(header string) ContactField {
+	header = strings.TrimSpace(header)
+	if field, ok := o.HeaderMapping[header]; ok {
+		return field
+	}
+	for _, field := range importFields {
+		if strings.EqualFold(header, string(field)) {
+			return field
+		}
+	}
+	return ""
+}
+
+// containsField reports whether fields contains field.
+func containsField(fields []ContactField, field ContactField) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportOptions configures how contacts are exported.
+type ExportOptions struct {
+	// Columns are the fields to export, in order.
+	// Defaults to DefaultExportColumns.
+	Columns []ContactField
+}
+
+// ExportCSV writes every contact matching params to w as CSV, with a header
+// row of field names. Times are formatted as RFC 3339; empty values are
+// written for missing names and schedule times.
+//
+// Example:
+//
+//	err := client.Contacts.ExportCSV(ctx, os.Stdout, msgmorph.ListContactsParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	}, msgmorph.ExportOptions{
+//	    Columns: []msgmorph.ContactField{
+//	        msgmorph.ContactFieldEmail,
+//	        msgmorph.ContactFieldFeedbackSent,
+//	        msgmorph.ContactFieldFeedbackScheduledAt,
+//	    },
+//	})
+func (r *ContactsResource) ExportCSV(ctx context.Context, w io.Writer, params ListContactsParams, opts ExportOptions, reqOpts ...RequestOption) error {
+	columns := opts.columns()
+
+	cw := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = string(column)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns))
+	for contact, err := range r.All(ctx, params, reqOpts...) {
+		if err != nil {
+			return err
+		}
+		for i, column := range columns {
+			record[i] = contact.Field(column)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ExportNDJSON writes every contact matching params to w as newline-delimited
+// JSON, one object per contact containing the selected columns.
+//
+// Example:
+//
+//	err := client.Contacts.ExportNDJSON(ctx, f, msgmorph.ListContactsParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	}, msgmorph.ExportOptions{})
+func (r *ContactsResource) ExportNDJSON(ctx context.Context, w io.Writer, params ListContactsParams, opts ExportOptions, reqOpts ...RequestOption) error {
+	columns := opts.columns()
+
+	enc := json.NewEncoder(w)
+	for contact, err := range r.All(ctx, params, reqOpts...) {
+		if err != nil {
+			return err
+		}
+		obj := make(map[ContactField]interface{}, len(columns))
+		for _, column := range columns {
+			obj[column] = contact.field(column)
+		}
+		if err := enc.Encode(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columns returns the columns to export.
+func (o ExportOptions) columns() []ContactField {
+	if len(o.Columns) == 0 {
+		return DefaultExportColumns
+	}
+	return o.Columns
+}
+
+// Field returns a contact field formatted as ExportCSV writes it: times in
+// RFC 3339 format, booleans as "true" or "false", and unset or unknown fields
+// as an empty string.
+//
+// Example:
+//
+//	for _, column := range msgmorph.DefaultExportColumns {
+//	    fmt.Printf("%s: %s\n", column, contact.Field(column))
+//	}
+func (c Contact) Field(f ContactField) string {
+	return formatContactField(c.field(f))
+}
+
+// field returns the value of a contact field, or nil if it is not set.
+func (c Contact) field(f ContactField) interface{} {
+	switch f {
+	case ContactFieldID:
+		return c.ID
+	case ContactFieldExternalID:
+		return c.ExternalID
+	case ContactFieldEmail:
+		return c.Email
+	case ContactFieldName:
+		if c.Name == nil {
+			return nil
+		}
+		return *c.Name
+	case ContactFieldProjectID:
+		return c.ProjectID
+	case ContactFieldFeedbackSent:
+		return c.FeedbackSent
+	case ContactFieldFeedbackScheduledAt:
+		if c.FeedbackScheduledAt == nil {
+			return nil
+		}
+		return *c.FeedbackScheduledAt
+	case ContactFieldCreatedAt:
+		return c.CreatedAt
+	case ContactFieldUpdatedAt:
+		return c.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+// formatContactField formats a contact field value for CSV output.
+func formatContactField(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,122 @@
+package msgmorph
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	// EnvAPIKey holds the API key (required).
+	EnvAPIKey = "MSGMORPH_API_KEY"
+
+	// EnvOrganizationID holds the organization ID (required).
+	EnvOrganizationID = "MSGMORPH_ORGANIZATION_ID"
+
+	// EnvProjectID holds the default project ID (optional).
+	EnvProjectID = "MSGMORPH_PROJECT_ID"
+
+	// EnvBaseURL holds the API base URL (optional).
+	EnvBaseURL = "MSGMORPH_BASE_URL"
+
+	// EnvTimeout holds the HTTP client timeout (optional), either as a
+	// duration such as "45s" or as a number of seconds.
+	EnvTimeout = "MSGMORPH_TIMEOUT"
+)
+
+// NewClientFromEnv creates a new MsgMorph API client configured from
+// environment variables and, if one is selected, a configuration profile.
+//
+// The API key and organization ID are read from MSGMORPH_API_KEY and
+// MSGMORPH_ORGANIZATION_ID. MSGMORPH_PROJECT_ID, MSGMORPH_BASE_URL and
+// MSGMORPH_TIMEOUT optionally set the default project ID, base URL and
+// timeout.
+//
+// If MSGMORPH_PROFILE is set, that profile from the configuration file (see
+// DefaultConfigPath) provides the settings not set by the environment. If it
+// is not set and the environment lacks the API key or organization ID, the
+// file's default profile, if any, provides them. The file is not read when
+// the environment sets both, so a missing or malformed file does not matter.
+// Options passed to NewClientFromEnv take precedence over both. As with
+// Profile.NewClient, the timeout applies to the default HTTP client only and
+// is not applied to one set with WithHTTPClient.
+//
+// The configuration is validated as by NewClientE.
+//
+// Parameters:
+//   - opts: Optional configuration options
+//
+// Returns a configured Client, or an error joining every configuration
+// problem found. Each problem is an *Error.
+//
+// Example:
+//
+//	client, err := msgmorph.NewClientFromEnv()
+//	if err != nil {
+//	    log.Fatalf("invalid MsgMorph configuration: %v", err)
+//	}
+//
+// Errors:
+//   - ErrInvalidAPIKey: If no API key is set or it is malformed
+//   - ErrInvalidOrganizationID: If no organization ID is set
+//   - ErrInvalidConfiguration: If the base URL, timeout or profile is invalid
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	var env Profile
+	env.applyEnv()
+
+	var merged Profile
+	if os.Getenv(EnvProfile) != "" || env.APIKey == "" || env.OrganizationID == "" {
+		profile, err := loadEnvProfile()
+		if err != nil {
+			return nil, err
+		}
+		if profile != nil {
+			merged = *profile
+		}
+	}
+	merged.applyEnv()
+
+	return merged.NewClient(opts...)
+}
+
+// applyEnv overrides the profile's settings with those set by environment
+// variables.
+func (p *Profile) applyEnv() {
+	if apiKey := os.Getenv(EnvAPIKey); apiKey != "" {
+		p.APIKey = apiKey
+	}
+	if organizationID := os.Getenv(EnvOrganizationID); organizationID != "" {
+		p.OrganizationID = organizationID
+	}
+	if projectID := os.Getenv(EnvProjectID); projectID != "" {
+		p.ProjectID = projectID
+	}
+	if baseURL := os.Getenv(EnvBaseURL); baseURL != "" {
+		p.BaseURL = baseURL
+	}
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		p.Timeout = timeout
+	}
+}
+
+// parseTimeout parses a positive duration, such as "45s", or a number of
+// seconds.
+func parseTimeout(value string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds <= 0 {
+			return 0, fmt.Errorf("timeout must be positive")
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("timeout must be positive")
+	}
+	return d, nil
+}
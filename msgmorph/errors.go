@@ -1,13 +1,32 @@
 package msgmorph
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"syscall"
+	"time"
 )
 
 // ErrorCode represents error codes returned by the MsgMorph API.
+//
+// An ErrorCode is also an error, so the codes below serve as sentinels for
+// errors.Is: an *Error matches the ErrorCode equal to its Code.
+//
+//	if errors.Is(err, msgmorph.ErrNotFound) {
+//	    fmt.Println("Contact not found")
+//	}
 type ErrorCode string
 
+// Error implements the error interface, returning the code itself.
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
 // Error codes for MsgMorph API errors.
 const (
 	// Client errors
@@ -15,6 +34,8 @@ const (
 	ErrInvalidOrganizationID ErrorCode = "INVALID_ORGANIZATION_ID"
 	ErrMissingRequiredField  ErrorCode = "MISSING_REQUIRED_FIELD"
 	ErrValidationError       ErrorCode = "VALIDATION_ERROR"
+	ErrInvalidConfiguration  ErrorCode = "INVALID_CONFIGURATION"
+	ErrInvalidRequest        ErrorCode = "INVALID_REQUEST"
 
 	// Authentication errors
 	ErrUnauthorized ErrorCode = "UNAUTHORIZED"
@@ -24,14 +45,22 @@ const (
 	ErrNotFound      ErrorCode = "NOT_FOUND"
 	ErrConflict      ErrorCode = "CONFLICT"
 	ErrAlreadyExists ErrorCode = "ALREADY_EXISTS"
+	ErrRouteNotFound ErrorCode = "ROUTE_NOT_FOUND"
+
+	// Rate limiting errors
+	ErrRateLimited ErrorCode = "RATE_LIMITED"
 
 	// Server errors
 	ErrInternalError      ErrorCode = "INTERNAL_ERROR"
 	ErrServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
 
 	// Network errors
-	ErrNetworkError ErrorCode = "NETWORK_ERROR"
-	ErrTimeout      ErrorCode = "TIMEOUT"
+	ErrNetworkError      ErrorCode = "NETWORK_ERROR"
+	ErrTimeout           ErrorCode = "TIMEOUT"
+	ErrCanceled          ErrorCode = "CANCELED"
+	ErrDNSError          ErrorCode = "DNS_ERROR"
+	ErrConnectionRefused ErrorCode = "CONNECTION_REFUSED"
+	ErrTLSError          ErrorCode = "TLS_ERROR"
 )
 
 // errorMessages provides human-readable hints for common error codes.
@@ -43,11 +72,19 @@ var errorMessages = map[ErrorCode]string{
 	ErrNotFound:              "The requested resource was not found.",
 	ErrConflict:              "A conflict occurred. The resource may already exist or be in an invalid state.",
 	ErrAlreadyExists:         "This resource already exists. Use update instead of create.",
+	ErrRouteNotFound:         "The API does not support this endpoint. Please check the API URL and upgrade the API if needed.",
 	ErrValidationError:       "Invalid request data. Please check the required fields.",
+	ErrInvalidConfiguration:  "Invalid client configuration. Please check the client options, MSGMORPH_* environment variables and configuration file.",
+	ErrInvalidRequest:        "Invalid request. Please check the IDs and arguments passed to the call.",
+	ErrRateLimited:           "Too many requests. Please slow down and retry after the indicated delay.",
 	ErrInternalError:         "An internal server error occurred. Please try again later.",
 	ErrServiceUnavailable:    "The MsgMorph API is temporarily unavailable. Please try again later.",
 	ErrNetworkError:          "Network error. Please check your internet connection and that the API URL is correct.",
 	ErrTimeout:               "Request timed out. Please try again.",
+	ErrCanceled:              "The request was canceled.",
+	ErrDNSError:              "The API host name could not be resolved. Please check the API URL and your DNS configuration.",
+	ErrConnectionRefused:     "The API refused the connection. Please check the API URL and that the server is running.",
+	ErrTLSError:              "A secure connection to the API could not be established. Please check the API URL and the server's certificate.",
 }
 
 // Error represents an error returned by the MsgMorph API.
@@ -55,6 +92,9 @@ var errorMessages = map[ErrorCode]string{
 // Example usage:
 //
 //	contact, err := client.Contacts.Create(ctx, input)
+//	if errors.Is(err, msgmorph.ErrAlreadyExists) {
+//	    // ...
+//	}
 //	if err != nil {
 //	    var msgErr *msgmorph.Error
 //	    if errors.As(err, &msgErr) {
@@ -79,6 +119,30 @@ type Error struct {
 
 	// Details contains additional error information.
 	Details map[string]interface{} `json:"details,omitempty"`
+
+	// Attempts is the number of attempts made before the error was returned.
+	// It is greater than 1 when the request was retried.
+	Attempts int `json:"attempts,omitempty"`
+
+	// RetryAfter is the delay requested by the API before retrying, parsed
+	// from the Retry-After header. Zero if the header was not present.
+	RetryAfter time.Duration `json:"retryAfter,omitempty"`
+
+	// RateLimit contains the rate limit state reported by the API through
+	// the X-RateLimit-* headers. Nil if the headers were not present.
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// IdempotencyKey is the idempotency key sent with the request, if any.
+	// Retrying the request with WithIdempotencyKey and this key cannot
+	// apply it twice.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+
+	// cause is the underlying error, if any, returned by Unwrap.
+	cause error
+
+	// inferredCode is set when the API reported no error code, so Code was
+	// derived from Status.
+	inferredCode bool
 }
 
 // Error implements the error interface.
@@ -89,6 +153,41 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("MsgMorphError [%s]: %s", e.Code, e.Message)
 }
 
+// Is reports whether the error matches target, for use by errors.Is.
+//
+// target matches if it is an ErrorCode equal to the error's Code, or an
+// *Error with the same Code.
+//
+// Example:
+//
+//	_, err := client.Contacts.Get(ctx, "cnt_abc123")
+//	if errors.Is(err, msgmorph.ErrNotFound) {
+//	    fmt.Println("Contact not found")
+//	}
+func (e *Error) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return e.Code == t
+	case *Error:
+		return t != nil && e.Code == t.Code
+	}
+	return false
+}
+
+// Unwrap returns the underlying error, such as the *url.Error or
+// context.DeadlineExceeded that caused a network error, so that errors.Is
+// and errors.As can inspect it. Returns nil for errors returned by the API.
+//
+// Example:
+//
+//	_, err := client.Contacts.Get(ctx, "cnt_abc123")
+//	if errors.Is(err, context.Canceled) {
+//	    return
+//	}
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
 // newError creates a new Error with the given parameters.
 func newError(message string, status int, code ErrorCode, details map[string]interface{}) *Error {
 	hint := errorMessages[code]
@@ -120,6 +219,8 @@ func errorCodeFromStatus(status int) ErrorCode {
 		return ErrNotFound
 	case 409:
 		return ErrConflict
+	case 429:
+		return ErrRateLimited
 	case 503:
 		return ErrServiceUnavailable
 	default:
@@ -130,14 +231,66 @@ func errorCodeFromStatus(status int) ErrorCode {
 	}
 }
 
-// newNetworkError creates an Error for network-related failures.
+// newRequestError creates an Error for a request that could not be built.
+// It is not temporary.
+func newRequestError(err error) *Error {
+	e := newError(fmt.Sprintf("Failed to build request: %v", err), 0, ErrInvalidConfiguration, nil)
+	e.cause = err
+	return e
+}
+
+// newNetworkError creates an Error for network-related failures, with a
+// code classifying err.
 func newNetworkError(err error) *Error {
 	message := "Network request failed"
 	if err != nil {
 		message = err.Error()
 	}
 
-	return newError(message, 0, ErrNetworkError, nil)
+	e := newError(message, 0, networkErrorCode(err), nil)
+	e.cause = err
+	return e
+}
+
+// networkErrorCode classifies a transport error.
+func networkErrorCode(err error) ErrorCode {
+	var dnsErr *net.DNSError
+	var netErr net.Error
+
+	switch {
+	case err == nil:
+		return ErrNetworkError
+	case errors.Is(err, context.Canceled):
+		return ErrCanceled
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrTimeout
+	case errors.As(err, &dnsErr):
+		return ErrDNSError
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrConnectionRefused
+	case isTLSError(err):
+		return ErrTLSError
+	default:
+		return ErrNetworkError
+	}
+}
+
+// isTLSError reports whether err is a TLS handshake or certificate error.
+func isTLSError(err error) bool {
+	var (
+		verifyErr      *tls.CertificateVerificationError
+		recordErr      tls.RecordHeaderError
+		alertErr       tls.AlertError
+		authorityErr   x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+		certInvalidErr x509.CertificateInvalidError
+	)
+	return errors.As(err, &verifyErr) ||
+		errors.As(err, &recordErr) ||
+		errors.As(err, &alertErr) ||
+		errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &certInvalidErr)
 }
 
 // ToJSON converts the error to a JSON string for logging.
@@ -161,7 +314,38 @@ func (e *Error) IsValidationError() bool {
 	return e.Code == ErrValidationError
 }
 
+// IsRateLimited returns true if the request was rejected by rate limiting.
+func (e *Error) IsRateLimited() bool {
+	return e.Code == ErrRateLimited
+}
+
 // IsServerError returns true if the error is a server-side error.
 func (e *Error) IsServerError() bool {
 	return e.Code == ErrInternalError || e.Code == ErrServiceUnavailable
 }
+
+// IsTimeout returns true if the request timed out, either because the
+// context's deadline passed or the HTTP client's timeout elapsed.
+func (e *Error) IsTimeout() bool {
+	return e.Code == ErrTimeout
+}
+
+// IsTemporary returns true if the error is likely transient, so that the
+// request may succeed if retried: timeouts, refused connections, temporary
+// DNS failures, other transport failures, rate limiting and server errors.
+//
+// Cancellations, TLS errors, unknown hosts and client errors are not
+// temporary. Neither is a request that could not be built, for example
+// because of a malformed base URL; it fails with ErrInvalidConfiguration.
+func (e *Error) IsTemporary() bool {
+	switch e.Code {
+	case ErrTimeout, ErrConnectionRefused, ErrNetworkError, ErrRateLimited:
+		return true
+	case ErrDNSError:
+		var dnsErr *net.DNSError
+		return errors.As(e.cause, &dnsErr) && dnsErr.IsTemporary
+	case ErrCanceled, ErrTLSError:
+		return false
+	}
+	return e.IsServerError() || e.Status >= 500
+}
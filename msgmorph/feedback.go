@@ -0,0 +1,202 @@
+package msgmorph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// FeedbackResource provides methods to read feedback collected by MsgMorph.
+//
+// Feedback responses are submitted by contacts in reply to feedback requests.
+// Use this resource to list, get, and export responses.
+//
+// Example usage:
+//
+//	// List responses with a low score
+//	maxScore := 6
+//	responses, err := client.Feedback.List(ctx, msgmorph.ListFeedbackParams{
+//	    ProjectID: "proj-456",
+//	    MaxScore:  &maxScore,
+//	})
+//
+//	// Get a response
+//	response, err := client.Feedback.Get(ctx, "fb_abc123")
+//
+//	// Export every response as newline-delimited JSON
+//	err = client.Feedback.Export(ctx, os.Stdout, msgmorph.ListFeedbackParams{
+//	    ProjectID: "proj-456",
+//	})
+type FeedbackResource struct {
+	client *Client
+}
+
+// List retrieves a single page of feedback responses for a project.
+//
+// Use ListPage to access pagination details, or All to iterate over every
+// matching response.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - params: Query parameters for filtering responses
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns a slice of FeedbackResponse objects or an error.
+//
+// Example:
+//
+//	since := time.Now().AddDate(0, 0, -7)
+//	responses, err := client.Feedback.List(ctx, msgmorph.ListFeedbackParams{
+//	    ProjectID:      os.Getenv("MSGMORPH_PROJECT_ID"),
+//	    SubmittedAfter: &since,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, r := range responses {
+//	    fmt.Printf("Response %s from %s\n", r.ID, r.ContactID)
+//	}
+//
+// Errors:
+//   - ErrValidationError: If projectId is missing or a filter is invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *FeedbackResource) List(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) ([]FeedbackResponse, error) {
+	page, err := r.ListPage(ctx, params, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page.Data, nil
+}
+
+// ListPage retrieves a single page of feedback responses along with
+// pagination details.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - params: Query parameters for filtering and paginating responses
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns a FeedbackPage or an error.
+//
+// Errors:
+//   - ErrValidationError: If projectId is missing or a filter is invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *FeedbackResource) ListPage(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) (*FeedbackPage, error) {
+	params.ProjectID = r.client.projectIDOrDefault(params.ProjectID)
+
+	path, err := withQuery("/api/v1/feedback", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var page FeedbackPage
+	err = r.client.request(ctx, "feedback.list", http.MethodGet, path, nil, &page, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// All returns an iterator over every feedback response matching params.
+//
+// Pages are fetched lazily as the iteration progresses. If a page cannot be
+// fetched, the error is yielded and the iteration stops.
+//
+// Example:
+//
+//	for response, err := range client.Feedback.All(ctx, msgmorph.ListFeedbackParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	}) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Printf("Response: %s\n", response.ID)
+//	}
+func (r *FeedbackResource) All(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) iter.Seq2[FeedbackResponse, error] {
+	return paginate(params.Cursor, params.Offset, func(cursor string, offset int) (*FeedbackPage, error) {
+		params.Cursor = cursor
+		params.Offset = offset
+		return r.ListPage(ctx, params, opts...)
+	})
+}
+
+// Get retrieves a single feedback response by ID.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The feedback response's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns the FeedbackResponse or an error.
+//
+// Example:
+//
+//	response, err := client.Feedback.Get(ctx, "fb_abc123")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if response.Score != nil {
+//	    fmt.Printf("Score: %d\n", *response.Score)
+//	}
+//
+// Errors:
+//   - ErrNotFound: If the response doesn't exist
+//   - ErrUnauthorized: If the API key is invalid
+func (r *FeedbackResource) Get(ctx context.Context, id string, opts ...RequestOption) (*FeedbackResponse, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/feedback/%s", segment)
+
+	var response FeedbackResponse
+	err = r.client.request(ctx, "feedback.get", http.MethodGet, path, nil, &response, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Export writes every feedback response matching params to w as
+// newline-delimited JSON, one response per line.
+//
+// Pages are fetched lazily, so large exports do not need to fit in memory.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - w: Destination for the exported responses
+//   - params: Query parameters for filtering responses
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns nil on success or an error.
+//
+// Example:
+//
+//	f, err := os.Create("feedback.ndjson")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	err = client.Feedback.Export(ctx, f, msgmorph.ListFeedbackParams{
+//	    ProjectID: os.Getenv("MSGMORPH_PROJECT_ID"),
+//	})
+//
+// Errors:
+//   - ErrValidationError: If projectId is missing or a filter is invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *FeedbackResource) Export(ctx context.Context, w io.Writer, params ListFeedbackParams, opts ...RequestOption) error {
+	enc := json.NewEncoder(w)
+	for response, err := range r.All(ctx, params, opts...) {
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package msgmorph
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Instrumentation receives tracing and metrics hooks for every API call.
+//
+// StartCall is called before each SDK call, such as Contacts.Create, with
+// the request about to be sent; req.Operation names the call (for example
+// "contacts.create"). It returns the context to use for the call, which may
+// carry a span, and a function that is called exactly once with the outcome.
+//
+// StartCall may add headers to req.Header, for example to propagate trace
+// context. The otelmsgmorph module provides an OpenTelemetry implementation.
+//
+// Example:
+//
+//	type metrics struct{}
+//
+//	func (metrics) StartCall(ctx context.Context, req *msgmorph.Request) (context.Context, func(msgmorph.CallResult)) {
+//	    return ctx, func(res msgmorph.CallResult) {
+//	        requestDuration.WithLabelValues(req.Operation, string(res.ErrorCode)).Observe(res.Duration.Seconds())
+//	    }
+//	}
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithInstrumentation(metrics{}),
+//	)
+type Instrumentation interface {
+	StartCall(ctx context.Context, req *Request) (context.Context, func(CallResult))
+}
+
+// CallResult describes the outcome of an API call.
+type CallResult struct {
+	// StatusCode is the HTTP status code of the last response.
+	// Zero if the server did not respond.
+	StatusCode int
+
+	// Duration is the total time taken by the call, including retries.
+	Duration time.Duration
+
+	// Attempts is the number of attempts made.
+	Attempts int
+
+	// ErrorCode is the code of the returned error. Empty on success.
+	ErrorCode ErrorCode
+
+	// Err is the error returned by the call. Nil on success.
+	Err error
+}
+
+// WithInstrumentation sets the instrumentation notified of every API call
+// made by the client, across all resources.
+//
+// Example:
+//
+//	inst, err := otelmsgmorph.New()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithInstrumentation(inst),
+//	)
+func WithInstrumentation(instrumentation Instrumentation) ClientOption {
+	return func(c *Client) {
+		c.instrumentation = instrumentation
+	}
+}
+
+// newCallResult builds the CallResult for a finished call.
+func newCallResult(resp *Response, err error, duration time.Duration) CallResult {
+	result := CallResult{
+		Duration: duration,
+		Attempts: 1,
+		Err:      err,
+	}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+		if resp.attempts > 0 {
+			result.Attempts = resp.attempts
+		}
+	}
+
+	var msgErr *Error
+	if errors.As(err, &msgErr) {
+		result.ErrorCode = msgErr.Code
+		if msgErr.Attempts > 0 {
+			result.Attempts = msgErr.Attempts
+		}
+		if result.StatusCode == 0 {
+			result.StatusCode = msgErr.Status
+		}
+	} else if err != nil {
+		result.ErrorCode = ErrInternalError
+	}
+
+	return result
+}
@@ -0,0 +1,180 @@
+package msgmorph
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithRateLimit throttles all requests made by the client to rps requests per
+// second, allowing bursts of up to burst requests.
+//
+// The limit is shared by every resource and goroutine using the client, so a
+// single client can be used under heavy concurrency without exceeding the
+// API's rate limits. Requests wait for capacity until their context is done.
+// Retried attempts count against the limit as well.
+//
+// rps must be positive and burst at least 1. NewClientE reports an invalid
+// limit as ErrInvalidConfiguration; NewClient ignores it.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithRateLimit(10, 20),
+//	)
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		if err := validateRateLimit("WithRateLimit", rps, burst); err != nil {
+			c.optionErrs = append(c.optionErrs, err)
+			return
+		}
+		c.limiter().global = newTokenBucket(rps, burst)
+	}
+}
+
+// WithEndpointRateLimit overrides the client-wide rate limit for requests
+// whose path starts with pathPrefix. The prefix matches whole path segments,
+// so "/api/v1/contacts" matches "/api/v1/contacts" and
+// "/api/v1/contacts/cnt_abc123" but not "/api/v1/contactsX". If method is
+// empty, the limit applies to all methods. When several overrides match, the
+// longest prefix wins.
+//
+// rps must be positive and burst at least 1. NewClientE reports an invalid
+// limit as ErrInvalidConfiguration; NewClient ignores it.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithRateLimit(10, 20),
+//	    msgmorph.WithEndpointRateLimit(http.MethodPost, "/api/v1/contacts", 2, 5),
+//	)
+func WithEndpointRateLimit(method, pathPrefix string, rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		if err := validateRateLimit("WithEndpointRateLimit", rps, burst); err != nil {
+			c.optionErrs = append(c.optionErrs, err)
+			return
+		}
+		l := c.limiter()
+		l.endpoints = append(l.endpoints, endpointLimit{
+			method: strings.ToUpper(method),
+			prefix: pathPrefix,
+			bucket: newTokenBucket(rps, burst),
+		})
+	}
+}
+
+// validateRateLimit returns an error if rps or burst is invalid.
+func validateRateLimit(option string, rps float64, burst int) *Error {
+	if rps > 0 && !math.IsInf(rps, 1) && burst >= 1 {
+		return nil
+	}
+	return newError(
+		fmt.Sprintf("%s: rps must be positive and burst at least 1, got rps %v and burst %d.", option, rps, burst),
+		400,
+		ErrInvalidConfiguration,
+		nil,
+	)
+}
+
+// limiter returns the client's rate limiter, creating it if needed.
+func (c *Client) limiter() *rateLimiter {
+	if c.rateLimiter == nil {
+		c.rateLimiter = &rateLimiter{}
+	}
+	return c.rateLimiter
+}
+
+// rateLimiter selects the token bucket that applies to a request.
+type rateLimiter struct {
+	global    *tokenBucket
+	endpoints []endpointLimit
+}
+
+// endpointLimit is a rate limit override for a group of endpoints.
+type endpointLimit struct {
+	method string
+	prefix string
+	bucket *tokenBucket
+}
+
+// wait blocks until the request identified by method and path may proceed.
+func (l *rateLimiter) wait(ctx context.Context, method, path string) error {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	bucket := l.global
+	matched := -1
+	for _, e := range l.endpoints {
+		if e.method != "" && e.method != method {
+			continue
+		}
+		if hasPathPrefix(path, e.prefix) && len(e.prefix) > matched {
+			bucket = e.bucket
+			matched = len(e.prefix)
+		}
+	}
+
+	if bucket == nil {
+		return nil
+	}
+	return bucket.wait(ctx)
+}
+
+// hasPathPrefix reports whether path starts with the path segments of prefix.
+func hasPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || strings.HasSuffix(prefix, "/") || path[len(prefix)] == '/'
+}
+
+// tokenBucket is a context-aware token bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a full bucket refilled at rps tokens per second.
+// rps and burst must have been validated with validateRateLimit.
+func newTokenBucket(rps float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, blocking until one is available or ctx
+// is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
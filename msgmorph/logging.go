@@ -0,0 +1,127 @@
+package msgmorph
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// WithLogger enables structured logging of API calls to logger.
+//
+// Each attempt is logged at debug level, and each completed call is logged
+// at info level, or warn level if it failed. Records include the method,
+// path, status, duration, attempt count and error code. The API key is never
+// logged, and email addresses in paths are redacted.
+//
+// Example:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+//	    Level: slog.LevelDebug,
+//	}))
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithLogger(logger),
+//	)
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithBodyLogging enables logging of request and response bodies at the
+// given level. It has no effect unless a logger is set with WithLogger.
+//
+// Email addresses and the API key are redacted from logged bodies.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithLogger(logger),
+//	    msgmorph.WithBodyLogging(slog.LevelDebug),
+//	)
+func WithBodyLogging(level slog.Level) ClientOption {
+	return func(c *Client) {
+		c.logBodies = true
+		c.bodyLogLevel = level
+	}
+}
+
+// logAttempt logs a single request attempt.
+func (c *Client) logAttempt(ctx context.Context, req *Request, attempt int, resp *Response, err *Error, duration time.Duration) {
+	if c.logger == nil || !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", c.redact(req.Path)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", duration),
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error_code", string(err.Code)), slog.String("error", c.redact(err.Message)))
+	}
+
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "msgmorph: request attempt", attrs...)
+}
+
+// logBody logs a request or response body, if body logging is enabled.
+func (c *Client) logBody(ctx context.Context, req *Request, msg string, body []byte) {
+	if c.logger == nil || !c.logBodies || len(body) == 0 || !c.logger.Enabled(ctx, c.bodyLogLevel) {
+		return
+	}
+
+	c.logger.LogAttrs(ctx, c.bodyLogLevel, msg,
+		slog.String("method", req.Method),
+		slog.String("path", c.redact(req.Path)),
+		slog.String("body", c.redact(string(body))),
+	)
+}
+
+// logRequest logs a completed API call.
+func (c *Client) logRequest(ctx context.Context, req *Request, attempts int, resp *Response, err *Error, duration time.Duration) {
+	if c.logger == nil {
+		return
+	}
+
+	level := slog.LevelInfo
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", c.redact(req.Path)),
+		slog.Int("attempts", attempts),
+		slog.Duration("duration", duration),
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error_code", string(err.Code)), slog.String("error", c.redact(err.Message)))
+	}
+
+	c.logger.LogAttrs(ctx, level, "msgmorph: request", attrs...)
+}
+
+// emailPattern matches email addresses, including URL-encoded ones.
+var emailPattern = regexp.MustCompile(`([A-Za-z0-9._%+\-]+)(@|%40)([A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+// redact masks email addresses and the API key in s.
+func (c *Client) redact(s string) string {
+	if c.apiKey != "" {
+		s = strings.ReplaceAll(s, c.apiKey, "[REDACTED]")
+	}
+	return emailPattern.ReplaceAllStringFunc(s, func(email string) string {
+		m := emailPattern.FindStringSubmatch(email)
+		return m[1][:1] + "***" + m[2] + m[3]
+	})
+}
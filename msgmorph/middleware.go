@@ -0,0 +1,86 @@
+package msgmorph
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request describes an API call as seen by middleware.
+type Request struct {
+	// Operation names the SDK call, such as "contacts.create".
+	Operation string
+
+	// Method is the HTTP method.
+	Method string
+
+	// Path is the request path relative to the base URL, including the
+	// query string.
+	Path string
+
+	// Body is the value that will be encoded as the JSON request body,
+	// such as a CreateContactInput. Nil if the request has no body.
+	Body interface{}
+
+	// Header contains the headers that will be sent, including the
+	// authentication headers. Middleware may add, change or remove headers.
+	Header http.Header
+
+	// baseURL is the API URL the request is sent to.
+	baseURL string
+
+	// retryPolicy controls retries of the request.
+	retryPolicy RetryPolicy
+}
+
+// Response describes an API response as seen by middleware.
+type Response struct {
+	// StatusCode is the HTTP status code.
+	StatusCode int
+
+	// Header contains the response headers.
+	Header http.Header
+
+	// Body is the raw response body.
+	Body []byte
+
+	// attempts is the number of attempts made to obtain the response.
+	attempts int
+}
+
+// RoundTripFunc performs an API call.
+//
+// If the server responded, the Response is non-nil, even when an error is
+// returned. Errors produced by the SDK are of type *Error.
+type RoundTripFunc func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a RoundTripFunc to observe or modify API calls.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middleware that wraps every API call made by the
+// client, across all resources.
+//
+// Middleware run in the order they are added: the first one added is the
+// outermost and sees the call first. Middleware wrap the whole call,
+// including retries; the *Error returned by next records the attempts made.
+//
+// Example:
+//
+//	tracing := func(next msgmorph.RoundTripFunc) msgmorph.RoundTripFunc {
+//	    return func(ctx context.Context, req *msgmorph.Request) (*msgmorph.Response, error) {
+//	        req.Header.Set("X-Request-Id", requestIDFromContext(ctx))
+//	        resp, err := next(ctx, req)
+//	        if resp != nil {
+//	            log.Printf("%s %s -> %d", req.Method, req.Path, resp.StatusCode)
+//	        }
+//	        return resp, err
+//	    }
+//	}
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithMiddleware(tracing),
+//	)
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+}
@@ -0,0 +1,298 @@
+package msgmorphtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// defaultPageSize is the page size used when a list request has no limit.
+const defaultPageSize = 50
+
+// createContact handles POST /api/v1/contacts.
+func (s *Server) createContact(w http.ResponseWriter, r *http.Request) {
+	var input msgmorph.CreateContactInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "Invalid JSON body")
+		return
+	}
+
+	switch {
+	case input.ExternalID == "":
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "externalId is required")
+		return
+	case input.Email == "":
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "email is required")
+		return
+	case input.ProjectID == "":
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "projectId is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.contacts {
+		if c.ProjectID == input.ProjectID && c.ExternalID == input.ExternalID {
+			writeError(w, http.StatusConflict, msgmorph.ErrAlreadyExists,
+				fmt.Sprintf("Contact with externalId %q already exists", input.ExternalID))
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	contact := &msgmorph.Contact{
+		ID:         s.newID(),
+		ExternalID: input.ExternalID,
+		Email:      input.Email,
+		ProjectID:  input.ProjectID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if input.Name != "" {
+		name := input.Name
+		contact.Name = &name
+	}
+
+	s.contacts[contact.ID] = contact
+	s.order = append(s.order, contact.ID)
+	writeJSON(w, http.StatusCreated, contact)
+}
+
+// listContacts handles GET /api/v1/contacts.
+func (s *Server) listContacts(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	projectID := q.Get("projectId")
+	if projectID == "" {
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "projectId is required")
+		return
+	}
+
+	limit := defaultPageSize
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+
+	offset := 0
+	for _, key := range []string{"cursor", "offset"} {
+		if v := q.Get(key); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, key+" is invalid")
+				return
+			}
+			offset = n
+			break
+		}
+	}
+
+	s.mu.Lock()
+	var matches []msgmorph.Contact
+	for _, id := range s.order {
+		c := s.contacts[id]
+		if c.ProjectID != projectID {
+			continue
+		}
+		if v := q.Get("email"); v != "" && !strings.EqualFold(c.Email, v) {
+			continue
+		}
+		if v := q.Get("externalId"); v != "" && c.ExternalID != v {
+			continue
+		}
+		if v := q.Get("feedbackSent"); v != "" && strconv.FormatBool(c.FeedbackSent) != v {
+			continue
+		}
+		matches = append(matches, *c)
+	}
+	s.mu.Unlock()
+
+	sortContacts(matches, msgmorph.ContactSortField(q.Get("sortBy")), msgmorph.SortOrder(q.Get("sortOrder")))
+
+	page := msgmorph.ContactPage{Data: []msgmorph.Contact{}, Total: len(matches)}
+	if offset < len(matches) {
+		end := min(offset+limit, len(matches))
+		page.Data = matches[offset:end]
+		if end < len(matches) {
+			page.HasMore = true
+			page.NextCursor = strconv.Itoa(end)
+		}
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// getContact handles GET /api/v1/contacts/{id}.
+func (s *Server) getContact(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, contact)
+}
+
+// updateContact handles PATCH /api/v1/contacts/{id}.
+func (s *Server) updateContact(w http.ResponseWriter, r *http.Request) {
+	var input msgmorph.UpdateContactInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "Invalid JSON body")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	if input.Email != "" {
+		contact.Email = input.Email
+	}
+	if input.Name != "" {
+		name := input.Name
+		contact.Name = &name
+	}
+	contact.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, contact)
+}
+
+// deleteContact handles DELETE /api/v1/contacts/{id}.
+func (s *Server) deleteContact(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	delete(s.contacts, contact.ID)
+	for i, id := range s.order {
+		if id == contact.ID {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sendFeedbackRequest handles POST /api/v1/contacts/{id}/feedback-request.
+func (s *Server) sendFeedbackRequest(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	if contact.FeedbackSent {
+		writeError(w, http.StatusConflict, msgmorph.ErrConflict, "Feedback has already been sent to this contact")
+		return
+	}
+	contact.FeedbackSent = true
+	contact.FeedbackScheduledAt = nil
+	contact.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, contact)
+}
+
+// scheduleFeedback handles PUT /api/v1/contacts/{id}/feedback-schedule.
+func (s *Server) scheduleFeedback(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		ScheduledAt time.Time `json:"scheduledAt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "Invalid JSON body")
+		return
+	}
+	if input.ScheduledAt.Before(time.Now()) {
+		writeError(w, http.StatusBadRequest, msgmorph.ErrValidationError, "scheduledAt must be in the future")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	at := input.ScheduledAt.UTC()
+	contact.FeedbackScheduledAt = &at
+	contact.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, contact)
+}
+
+// cancelScheduledFeedback handles DELETE /api/v1/contacts/{id}/feedback-schedule.
+func (s *Server) cancelScheduledFeedback(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contact, ok := s.lookup(w, r)
+	if !ok {
+		return
+	}
+	if contact.FeedbackScheduledAt == nil {
+		writeError(w, http.StatusNotFound, msgmorph.ErrNotFound, "Contact has no scheduled feedback request")
+		return
+	}
+	contact.FeedbackScheduledAt = nil
+	contact.UpdatedAt = time.Now().UTC()
+	writeJSON(w, http.StatusOK, contact)
+}
+
+// lookup returns the contact identified by the request's {id} path value,
+// writing a 404 response if it does not exist. The caller must hold s.mu.
+func (s *Server) lookup(w http.ResponseWriter, r *http.Request) (*msgmorph.Contact, bool) {
+	id := r.PathValue("id")
+	contact, ok := s.contacts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, msgmorph.ErrNotFound, fmt.Sprintf("Contact %q not found", id))
+		return nil, false
+	}
+	return contact, true
+}
+
+// sortContacts sorts contacts in place. Contacts are in creation order by
+// default.
+func sortContacts(contacts []msgmorph.Contact, by msgmorph.ContactSortField, order msgmorph.SortOrder) {
+	var less func(a, b msgmorph.Contact) bool
+	switch by {
+	case msgmorph.ContactSortEmail:
+		less = func(a, b msgmorph.Contact) bool { return a.Email < b.Email }
+	case msgmorph.ContactSortName:
+		less = func(a, b msgmorph.Contact) bool { return stringValue(a.Name) < stringValue(b.Name) }
+	case msgmorph.ContactSortUpdatedAt:
+		less = func(a, b msgmorph.Contact) bool { return a.UpdatedAt.Before(b.UpdatedAt) }
+	}
+
+	if less != nil {
+		sort.SliceStable(contacts, func(i, j int) bool {
+			return less(contacts[i], contacts[j])
+		})
+	}
+	if order == msgmorph.SortDesc {
+		for i, j := 0, len(contacts)-1; i < j; i, j = i+1, j-1 {
+			contacts[i], contacts[j] = contacts[j], contacts[i]
+		}
+	}
+}
+
+// stringValue returns the value of s, or "" if s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,297 @@
+package msgmorphtest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// TestingT is the subset of testing.TB used by the assertion helpers.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Call is a method call recorded by a mock.
+type Call struct {
+	// Method is the name of the called method, such as "Create".
+	Method string
+
+	// Args are the arguments passed after the context.
+	Args []interface{}
+}
+
+// MockContacts is a hand-written mock implementation of
+// msgmorph.ContactsService.
+//
+// Set the Func field for each method the code under test is expected to
+// call. Calling a method whose Func field is nil returns an error. Every
+// call is recorded and can be inspected with Calls or the Assert helpers.
+// Request options, such as msgmorph.WithIdempotencyKey, are accepted but not
+// passed to the Func fields.
+//
+// Example:
+//
+//	mock := &msgmorphtest.MockContacts{
+//	    CreateFunc: func(ctx context.Context, input msgmorph.CreateContactInput) (*msgmorph.Contact, error) {
+//	        return &msgmorph.Contact{ID: "cnt_1", ExternalID: input.ExternalID, Email: input.Email}, nil
+//	    },
+//	}
+//
+//	syncer := &Syncer{Contacts: mock}
+//	syncer.Run(ctx)
+//
+//	mock.AssertCalled(t, "Create", 1)
+//	mock.AssertNotCalled(t, "Delete")
+type MockContacts struct {
+	CreateFunc                  func(ctx context.Context, input msgmorph.CreateContactInput) (*msgmorph.Contact, error)
+	ListFunc                    func(ctx context.Context, params msgmorph.ListContactsParams) ([]msgmorph.Contact, error)
+	ListPageFunc                func(ctx context.Context, params msgmorph.ListContactsParams) (*msgmorph.ContactPage, error)
+	AllFunc                     func(ctx context.Context, params msgmorph.ListContactsParams) iter.Seq2[msgmorph.Contact, error]
+	GetFunc                     func(ctx context.Context, id string) (*msgmorph.Contact, error)
+	GetByExternalIDFunc         func(ctx context.Context, projectID, externalID string) (*msgmorph.Contact, error)
+	UpdateFunc                  func(ctx context.Context, id string, input msgmorph.UpdateContactInput) (*msgmorph.Contact, error)
+	UpsertFunc                  func(ctx context.Context, input msgmorph.CreateContactInput) (*msgmorph.Contact, bool, error)
+	DeleteFunc                  func(ctx context.Context, id string) error
+	SendFeedbackRequestFunc     func(ctx context.Context, id string, input msgmorph.SendFeedbackRequestInput) (*msgmorph.Contact, error)
+	ScheduleFeedbackFunc        func(ctx context.Context, id string, at time.Time) (*msgmorph.Contact, error)
+	CancelScheduledFeedbackFunc func(ctx context.Context, id string) (*msgmorph.Contact, error)
+	BulkCreateFunc              func(ctx context.Context, inputs []msgmorph.CreateContactInput, opts msgmorph.BulkOptions) (*msgmorph.BulkResult, error)
+	BulkCreateStreamFunc        func(ctx context.Context, inputs <-chan msgmorph.CreateContactInput, opts msgmorph.BulkOptions) (*msgmorph.BulkResult, error)
+	BulkUpsertFunc              func(ctx context.Context, inputs []msgmorph.CreateContactInput, opts msgmorph.BulkOptions) (*msgmorph.BulkResult, error)
+	BulkUpsertStreamFunc        func(ctx context.Context, inputs <-chan msgmorph.CreateContactInput, opts msgmorph.BulkOptions) (*msgmorph.BulkResult, error)
+	ExportCSVFunc               func(ctx context.Context, w io.Writer, params msgmorph.ListContactsParams, opts msgmorph.ExportOptions) error
+	ExportNDJSONFunc            func(ctx context.Context, w io.Writer, params msgmorph.ListContactsParams, opts msgmorph.ExportOptions) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ msgmorph.ContactsService = (*MockContacts)(nil)
+
+// Create implements msgmorph.ContactsService.
+func (m *MockContacts) Create(ctx context.Context, input msgmorph.CreateContactInput, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("Create", input)
+	if m.CreateFunc == nil {
+		return nil, m.unexpected("Create")
+	}
+	return m.CreateFunc(ctx, input)
+}
+
+// List implements msgmorph.ContactsService.
+func (m *MockContacts) List(ctx context.Context, params msgmorph.ListContactsParams, _ ...msgmorph.RequestOption) ([]msgmorph.Contact, error) {
+	m.record("List", params)
+	if m.ListFunc == nil {
+		return nil, m.unexpected("List")
+	}
+	return m.ListFunc(ctx, params)
+}
+
+// ListPage implements msgmorph.ContactsService.
+func (m *MockContacts) ListPage(ctx context.Context, params msgmorph.ListContactsParams, _ ...msgmorph.RequestOption) (*msgmorph.ContactPage, error) {
+	m.record("ListPage", params)
+	if m.ListPageFunc == nil {
+		return nil, m.unexpected("ListPage")
+	}
+	return m.ListPageFunc(ctx, params)
+}
+
+// All implements msgmorph.ContactsService.
+func (m *MockContacts) All(ctx context.Context, params msgmorph.ListContactsParams, _ ...msgmorph.RequestOption) iter.Seq2[msgmorph.Contact, error] {
+	m.record("All", params)
+	if m.AllFunc == nil {
+		err := m.unexpected("All")
+		return func(yield func(msgmorph.Contact, error) bool) {
+			yield(msgmorph.Contact{}, err)
+		}
+	}
+	return m.AllFunc(ctx, params)
+}
+
+// Get implements msgmorph.ContactsService.
+func (m *MockContacts) Get(ctx context.Context, id string, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		return nil, m.unexpected("Get")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// GetByExternalID implements msgmorph.ContactsService.
+func (m *MockContacts) GetByExternalID(ctx context.Context, projectID, externalID string, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("GetByExternalID", projectID, externalID)
+	if m.GetByExternalIDFunc == nil {
+		return nil, m.unexpected("GetByExternalID")
+	}
+	return m.GetByExternalIDFunc(ctx, projectID, externalID)
+}
+
+// Update implements msgmorph.ContactsService.
+func (m *MockContacts) Update(ctx context.Context, id string, input msgmorph.UpdateContactInput, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("Update", id, input)
+	if m.UpdateFunc == nil {
+		return nil, m.unexpected("Update")
+	}
+	return m.UpdateFunc(ctx, id, input)
+}
+
+// Upsert implements msgmorph.ContactsService.
+func (m *MockContacts) Upsert(ctx context.Context, input msgmorph.CreateContactInput, _ ...msgmorph.RequestOption) (*msgmorph.Contact, bool, error) {
+	m.record("Upsert", input)
+	if m.UpsertFunc == nil {
+		return nil, false, m.unexpected("Upsert")
+	}
+	return m.UpsertFunc(ctx, input)
+}
+
+// Delete implements msgmorph.ContactsService.
+func (m *MockContacts) Delete(ctx context.Context, id string, _ ...msgmorph.RequestOption) error {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		return m.unexpected("Delete")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// SendFeedbackRequest implements msgmorph.ContactsService.
+func (m *MockContacts) SendFeedbackRequest(ctx context.Context, id string, input msgmorph.SendFeedbackRequestInput, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("SendFeedbackRequest", id, input)
+	if m.SendFeedbackRequestFunc == nil {
+		return nil, m.unexpected("SendFeedbackRequest")
+	}
+	return m.SendFeedbackRequestFunc(ctx, id, input)
+}
+
+// ScheduleFeedback implements msgmorph.ContactsService.
+func (m *MockContacts) ScheduleFeedback(ctx context.Context, id string, at time.Time, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("ScheduleFeedback", id, at)
+	if m.ScheduleFeedbackFunc == nil {
+		return nil, m.unexpected("ScheduleFeedback")
+	}
+	return m.ScheduleFeedbackFunc(ctx, id, at)
+}
+
+// CancelScheduledFeedback implements msgmorph.ContactsService.
+func (m *MockContacts) CancelScheduledFeedback(ctx context.Context, id string, _ ...msgmorph.RequestOption) (*msgmorph.Contact, error) {
+	m.record("CancelScheduledFeedback", id)
+	if m.CancelScheduledFeedbackFunc == nil {
+		return nil, m.unexpected("CancelScheduledFeedback")
+	}
+	return m.CancelScheduledFeedbackFunc(ctx, id)
+}
+
+// BulkCreate implements msgmorph.ContactsService.
+func (m *MockContacts) BulkCreate(ctx context.Context, inputs []msgmorph.CreateContactInput, opts msgmorph.BulkOptions, _ ...msgmorph.RequestOption) (*msgmorph.BulkResult, error) {
+	m.record("BulkCreate", inputs, opts)
+	if m.BulkCreateFunc == nil {
+		return nil, m.unexpected("BulkCreate")
+	}
+	return m.BulkCreateFunc(ctx, inputs, opts)
+}
+
+// BulkCreateStream implements msgmorph.ContactsService.
+func (m *MockContacts) BulkCreateStream(ctx context.Context, inputs <-chan msgmorph.CreateContactInput, opts msgmorph.BulkOptions, _ ...msgmorph.RequestOption) (*msgmorph.BulkResult, error) {
+	m.record("BulkCreateStream", inputs, opts)
+	if m.BulkCreateStreamFunc == nil {
+		return nil, m.unexpected("BulkCreateStream")
+	}
+	return m.BulkCreateStreamFunc(ctx, inputs, opts)
+}
+
+// BulkUpsert implements msgmorph.ContactsService.
+func (m *MockContacts) BulkUpsert(ctx context.Context, inputs []msgmorph.CreateContactInput, opts msgmorph.BulkOptions, _ ...msgmorph.RequestOption) (*msgmorph.BulkResult, error) {
+	m.record("BulkUpsert", inputs, opts)
+	if m.BulkUpsertFunc == nil {
+		return nil, m.unexpected("BulkUpsert")
+	}
+	return m.BulkUpsertFunc(ctx, inputs, opts)
+}
+
+// BulkUpsertStream implements msgmorph.ContactsService.
+func (m *MockContacts) BulkUpsertStream(ctx context.Context, inputs <-chan msgmorph.CreateContactInput, opts msgmorph.BulkOptions, _ ...msgmorph.RequestOption) (*msgmorph.BulkResult, error) {
+	m.record("BulkUpsertStream", inputs, opts)
+	if m.BulkUpsertStreamFunc == nil {
+		return nil, m.unexpected("BulkUpsertStream")
+	}
+	return m.BulkUpsertStreamFunc(ctx, inputs, opts)
+}
+
+// ExportCSV implements msgmorph.ContactsService.
+func (m *MockContacts) ExportCSV(ctx context.Context, w io.Writer, params msgmorph.ListContactsParams, opts msgmorph.ExportOptions, _ ...msgmorph.RequestOption) error {
+	m.record("ExportCSV", w, params, opts)
+	if m.ExportCSVFunc == nil {
+		return m.unexpected("ExportCSV")
+	}
+	return m.ExportCSVFunc(ctx, w, params, opts)
+}
+
+// ExportNDJSON implements msgmorph.ContactsService.
+func (m *MockContacts) ExportNDJSON(ctx context.Context, w io.Writer, params msgmorph.ListContactsParams, opts msgmorph.ExportOptions, _ ...msgmorph.RequestOption) error {
+	m.record("ExportNDJSON", w, params, opts)
+	if m.ExportNDJSONFunc == nil {
+		return m.unexpected("ExportNDJSON")
+	}
+	return m.ExportNDJSONFunc(ctx, w, params, opts)
+}
+
+// Calls returns the calls made to the mock so far, in order.
+func (m *MockContacts) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallCount returns the number of times method was called.
+func (m *MockContacts) CallCount(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n := 0
+	for _, call := range m.calls {
+		if call.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+// AssertCalled reports a test error unless method was called exactly times
+// times.
+func (m *MockContacts) AssertCalled(t TestingT, method string, times int) bool {
+	t.Helper()
+	if n := m.CallCount(method); n != times {
+		t.Errorf("msgmorphtest: expected %s to be called %d time(s), got %d", method, times, n)
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled reports a test error if method was called.
+func (m *MockContacts) AssertNotCalled(t TestingT, method string) bool {
+	t.Helper()
+	return m.AssertCalled(t, method, 0)
+}
+
+// Reset clears the recorded calls.
+func (m *MockContacts) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+// record appends a call to the call log.
+func (m *MockContacts) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// unexpected returns the error for a call to a method without a Func.
+func (m *MockContacts) unexpected(method string) error {
+	return fmt.Errorf("msgmorphtest: unexpected call to MockContacts.%s (%sFunc is not set)", method, method)
+}
@@ -0,0 +1,447 @@
+// Package msgmorphtest provides an in-memory fake of the MsgMorph API for
+// testing code that uses the msgmorph package.
+//
+// The fake server implements the contacts API statefully, checks the
+// authentication headers sent by the client, replays the response to
+// mutating requests that reuse an Idempotency-Key, records every request,
+// and can inject latency and failures.
+//
+// # Quick Start
+//
+//	func TestSync(t *testing.T) {
+//	    srv := msgmorphtest.NewServer()
+//	    defer srv.Close()
+//
+//	    client := srv.Client()
+//	    contact, err := client.Contacts.Create(context.Background(), msgmorph.CreateContactInput{
+//	        ExternalID: "user-123",
+//	        Email:      "user@example.com",
+//	        ProjectID:  msgmorphtest.ProjectID,
+//	    })
+//	    if err != nil {
+//	        t.Fatal(err)
+//	    }
+//
+//	    srv.InjectFault(msgmorphtest.Fault{Status: http.StatusServiceUnavailable, Times: 1})
+//	    // ...
+//	}
+package msgmorphtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// Default credentials accepted by the fake server.
+const (
+	APIKey         = "msgmorph_test_key"
+	OrganizationID = "org_test"
+	ProjectID      = "proj_test"
+)
+
+// Option is a function that configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the API key and organization ID the server accepts.
+// Defaults to APIKey and OrganizationID.
+func WithCredentials(apiKey, organizationID string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+		s.organizationID = organizationID
+	}
+}
+
+// WithLatency delays every response by d.
+func WithLatency(d time.Duration) Option {
+	return func(s *Server) {
+		s.latency = d
+	}
+}
+
+// Fault describes a failure the server injects instead of handling matching
+// requests normally.
+type Fault struct {
+	// Method restricts the fault to requests with this HTTP method.
+	// Empty matches every method.
+	Method string
+
+	// PathPrefix restricts the fault to requests whose path starts with it.
+	// It matches whole path segments, so "/api/v1/contacts" matches
+	// "/api/v1/contacts/cnt_000001" but not "/api/v1/contactsX". Empty
+	// matches every path.
+	PathPrefix string
+
+	// Status is the HTTP status code to respond with. Defaults to 500 if it
+	// is not a valid status code.
+	Status int
+
+	// Code is the error code in the response body. Defaults to the code
+	// the SDK derives from Status.
+	Code msgmorph.ErrorCode
+
+	// Message is the error message in the response body.
+	Message string
+
+	// RetryAfter, if set, is sent in the Retry-After header.
+	RetryAfter time.Duration
+
+	// Times is the number of requests the fault applies to.
+	// Zero means every matching request until ClearFaults is called.
+	Times int
+}
+
+// RecordedRequest is a request received by the server.
+type RecordedRequest struct {
+	// Method is the HTTP method.
+	Method string
+
+	// Path is the URL path.
+	Path string
+
+	// Query is the raw, encoded query string.
+	Query string
+
+	// Header contains the request headers.
+	Header http.Header
+
+	// Body is the request body.
+	Body []byte
+}
+
+// Server is an in-memory fake of the MsgMorph API.
+//
+// Use NewServer to start a server and Client to get a client connected to
+// it. A Server is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server.
+	URL string
+
+	srv            *httptest.Server
+	apiKey         string
+	organizationID string
+
+	mu       sync.Mutex
+	latency  time.Duration
+	faults   []*Fault
+	requests []RecordedRequest
+	contacts map[string]*msgmorph.Contact
+	order    []string
+	nextID   int
+
+	// idempotent holds the responses to requests with an idempotency key.
+	idempotent map[string]*idempotentResponse
+}
+
+// idempotentResponse is the response to a request with an idempotency key.
+// done is closed once the first request with the key has completed.
+type idempotentResponse struct {
+	done     chan struct{}
+	recorder *httptest.ResponseRecorder
+}
+
+// NewServer starts a new fake MsgMorph API server.
+// The caller must call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		apiKey:         APIKey,
+		organizationID: OrganizationID,
+		contacts:       make(map[string]*msgmorph.Contact),
+		idempotent:     make(map[string]*idempotentResponse),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/contacts", s.createContact)
+	mux.HandleFunc("GET /api/v1/contacts", s.listContacts)
+	mux.HandleFunc("GET /api/v1/contacts/{id}", s.getContact)
+	mux.HandleFunc("PATCH /api/v1/contacts/{id}", s.updateContact)
+	mux.HandleFunc("DELETE /api/v1/contacts/{id}", s.deleteContact)
+	mux.HandleFunc("POST /api/v1/contacts/{id}/feedback-request", s.sendFeedbackRequest)
+	mux.HandleFunc("PUT /api/v1/contacts/{id}/feedback-schedule", s.scheduleFeedback)
+	mux.HandleFunc("DELETE /api/v1/contacts/{id}/feedback-schedule", s.cancelScheduledFeedback)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, msgmorph.ErrRouteNotFound, fmt.Sprintf("No route for %s %s", r.Method, r.URL.Path))
+	})
+
+	s.srv = httptest.NewServer(s.middleware(mux))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a msgmorph.Client configured to talk to the server with
+// the credentials it accepts. Additional options are applied after the
+// base URL is set.
+func (s *Server) Client(opts ...msgmorph.ClientOption) *msgmorph.Client {
+	opts = append([]msgmorph.ClientOption{
+		msgmorph.WithBaseURL(s.URL),
+		msgmorph.WithHTTPClient(s.srv.Client()),
+	}, opts...)
+	return msgmorph.NewClient(s.apiKey, s.organizationID, opts...)
+}
+
+// SetLatency delays every subsequent response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// InjectFault makes the server fail matching requests as described by f.
+// Faults are checked in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	if f.Status < 100 || f.Status > 999 {
+		f.Status = http.StatusInternalServerError
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// AddContact stores a contact directly, bypassing the API.
+// An ID and timestamps are assigned if missing. Returns the stored contact.
+func (s *Server) AddContact(c msgmorph.Contact) msgmorph.Contact {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	if c.ID == "" {
+		c.ID = s.newID()
+	}
+	if c.CreatedAt.IsZero() {
+		c.CreatedAt = now
+	}
+	if c.UpdatedAt.IsZero() {
+		c.UpdatedAt = now
+	}
+
+	if _, exists := s.contacts[c.ID]; !exists {
+		s.order = append(s.order, c.ID)
+	}
+	s.contacts[c.ID] = &c
+	return c
+}
+
+// Contacts returns all stored contacts, in creation order.
+func (s *Server) Contacts() []msgmorph.Contact {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contacts := make([]msgmorph.Contact, 0, len(s.order))
+	for _, id := range s.order {
+		contacts = append(contacts, *s.contacts[id])
+	}
+	return contacts
+}
+
+// Reset removes all contacts, recorded requests, idempotent responses and
+// faults.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.contacts = make(map[string]*msgmorph.Contact)
+	s.order = nil
+	s.requests = nil
+	s.faults = nil
+	s.idempotent = make(map[string]*idempotentResponse)
+}
+
+// middleware records requests, applies latency and faults, and checks the
+// authentication headers before calling next. Mutating requests with an
+// idempotency key are passed to idempotency.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		s.mu.Lock()
+		s.requests = append(s.requests, RecordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		latency := s.latency
+		fault := s.matchFault(r)
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fault != nil {
+			if fault.RetryAfter > 0 {
+				w.Header().Set("Retry-After", fmt.Sprint(int(math.Ceil(fault.RetryAfter.Seconds()))))
+			}
+			writeError(w, fault.Status, fault.Code, fault.Message)
+			return
+		}
+
+		if r.Header.Get("x-api-key") != s.apiKey {
+			writeError(w, http.StatusUnauthorized, msgmorph.ErrInvalidAPIKey, "Invalid API key")
+			return
+		}
+		if r.Header.Get("X-Organization-Id") != s.organizationID {
+			writeError(w, http.StatusUnauthorized, msgmorph.ErrInvalidOrganizationID, "Invalid organization ID")
+			return
+		}
+
+		if key := r.Header.Get(msgmorph.IdempotencyKeyHeader); key != "" && r.Method != http.MethodGet {
+			s.idempotency(w, r, key, next)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// idempotency calls next the first time a key is seen and replays the stored
+// response for later requests with the same key. A request that arrives while
+// the first one is still running waits for it to complete. Server errors are
+// not stored, so the request can be retried.
+func (s *Server) idempotency(w http.ResponseWriter, r *http.Request, key string, next http.Handler) {
+	key = r.Method + " " + r.URL.Path + " " + key
+
+	var stored *httptest.ResponseRecorder
+	for stored == nil {
+		// Checking for the key and reserving it happen under one lock, so
+		// only one request with the key runs next.
+		s.mu.Lock()
+		entry, ok := s.idempotent[key]
+		if !ok {
+			entry = &idempotentResponse{done: make(chan struct{})}
+			s.idempotent[key] = entry
+		}
+		s.mu.Unlock()
+
+		if !ok {
+			stored = s.record(r, key, entry, next)
+			break
+		}
+
+		select {
+		case <-entry.done:
+		case <-r.Context().Done():
+			return
+		}
+		if entry.recorder != nil {
+			stored = entry.recorder
+			w.Header().Set("Idempotent-Replayed", "true")
+		}
+	}
+
+	for name, values := range stored.Header() {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(stored.Code)
+	w.Write(stored.Body.Bytes())
+}
+
+// record calls next for the request that reserved entry and stores its
+// response, unless it is a server error.
+func (s *Server) record(r *http.Request, key string, entry *idempotentResponse, next http.Handler) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	next.ServeHTTP(recorder, r)
+
+	s.mu.Lock()
+	if recorder.Code < 500 {
+		entry.recorder = recorder
+	} else if s.idempotent[key] == entry {
+		delete(s.idempotent, key)
+	}
+	s.mu.Unlock()
+	close(entry.done)
+
+	return recorder
+}
+
+// matchFault returns the first fault matching r, consuming one use of it.
+// The caller must hold s.mu.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && !strings.EqualFold(f.Method, r.Method) {
+			continue
+		}
+		if !hasPathPrefix(r.URL.Path, f.PathPrefix) {
+			continue
+		}
+
+		matched := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return &matched
+	}
+	return nil
+}
+
+// hasPathPrefix reports whether path starts with the path segments of prefix.
+func hasPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || prefix == "" || strings.HasSuffix(prefix, "/") || path[len(prefix)] == '/'
+}
+
+// newID returns a new unique contact ID. The caller must hold s.mu.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("cnt_%06d", s.nextID)
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the format used by the API.
+func writeError(w http.ResponseWriter, status int, code msgmorph.ErrorCode, message string) {
+	body := map[string]interface{}{"message": message}
+	if code != "" {
+		body["code"] = code
+	}
+	writeJSON(w, status, body)
+}
@@ -0,0 +1,20 @@
+module github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph/otelmsgmorph
+
+go 1.24.0
+
+require (
+	github.com/MHamzaAhmad/msgmorph-go-sdk v0.0.0
+	go.opentelemetry.io/otel v1.41.0
+	go.opentelemetry.io/otel/metric v1.41.0
+	go.opentelemetry.io/otel/trace v1.41.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+)
+
+// Until the SDK is tagged, build against the SDK in this repository.
+replace github.com/MHamzaAhmad/msgmorph-go-sdk => ../..
@@ -0,0 +1,186 @@
+// Package otelmsgmorph provides OpenTelemetry tracing and metrics for the
+// MsgMorph Go SDK.
+//
+// It is a separate module so that the SDK itself has no dependency on
+// OpenTelemetry.
+//
+// Each SDK call produces a client span named after the operation (for
+// example "contacts.create") and is recorded in three instruments:
+//   - msgmorph.client.requests: number of calls, by operation
+//   - msgmorph.client.errors: number of failed calls, by operation and error code
+//   - msgmorph.client.duration: call latency in seconds, by operation
+//
+// The trace context is propagated to the API using the globally configured
+// propagator.
+//
+// # Quick Start
+//
+//	inst, err := otelmsgmorph.New()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	client := msgmorph.NewClient(
+//	    os.Getenv("MSGMORPH_API_KEY"),
+//	    os.Getenv("MSGMORPH_ORGANIZATION_ID"),
+//	    msgmorph.WithInstrumentation(inst),
+//	)
+package otelmsgmorph
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// instrumentationName is the name reported for the tracer and meter.
+const instrumentationName = "github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph/otelmsgmorph"
+
+// Attribute keys set on spans and metrics.
+const (
+	operationKey = attribute.Key("msgmorph.operation")
+	errorCodeKey = attribute.Key("msgmorph.error_code")
+	attemptsKey  = attribute.Key("msgmorph.attempts")
+	methodKey    = attribute.Key("http.request.method")
+	statusKey    = attribute.Key("http.response.status_code")
+)
+
+// Option is a function that configures an Instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the tracer provider. Defaults to the global one.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider. Defaults to the global one.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator used to inject trace context into
+// request headers. Defaults to the global one.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+// Instrumentation implements msgmorph.Instrumentation using OpenTelemetry.
+type Instrumentation struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	requests   metric.Int64Counter
+	errors     metric.Int64Counter
+	duration   metric.Float64Histogram
+}
+
+var _ msgmorph.Instrumentation = (*Instrumentation)(nil)
+
+// New creates an Instrumentation.
+// Returns an error if the metric instruments cannot be created.
+func New(opts ...Option) (*Instrumentation, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	requests, err := meter.Int64Counter("msgmorph.client.requests",
+		metric.WithDescription("Number of MsgMorph API calls."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	errs, err := meter.Int64Counter("msgmorph.client.errors",
+		metric.WithDescription("Number of failed MsgMorph API calls."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("msgmorph.client.duration",
+		metric.WithDescription("Duration of MsgMorph API calls, including retries."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Instrumentation{
+		tracer:     cfg.tracerProvider.Tracer(instrumentationName),
+		propagator: cfg.propagator,
+		requests:   requests,
+		errors:     errs,
+		duration:   duration,
+	}, nil
+}
+
+// StartCall implements msgmorph.Instrumentation.
+func (i *Instrumentation) StartCall(ctx context.Context, req *msgmorph.Request) (context.Context, func(msgmorph.CallResult)) {
+	ctx, span := i.tracer.Start(ctx, req.Operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			operationKey.String(req.Operation),
+			methodKey.String(req.Method),
+			attribute.String("url.path", pathWithoutQuery(req.Path)),
+		),
+	)
+	i.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return ctx, func(res msgmorph.CallResult) {
+		operation := operationKey.String(req.Operation)
+
+		span.SetAttributes(attemptsKey.Int(res.Attempts))
+		if res.StatusCode > 0 {
+			span.SetAttributes(statusKey.Int(res.StatusCode))
+		}
+		if res.Err != nil {
+			span.SetAttributes(errorCodeKey.String(string(res.ErrorCode)))
+			span.RecordError(res.Err)
+			span.SetStatus(codes.Error, string(res.ErrorCode))
+		}
+		span.End()
+
+		i.requests.Add(ctx, 1, metric.WithAttributes(operation))
+		i.duration.Record(ctx, res.Duration.Seconds(), metric.WithAttributes(operation))
+		if res.Err != nil {
+			i.errors.Add(ctx, 1, metric.WithAttributes(operation, errorCodeKey.String(string(res.ErrorCode))))
+		}
+	}
+}
+
+// pathWithoutQuery strips the query string, which may contain personal data
+// such as email filters, from a request path.
+func pathWithoutQuery(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
@@ -0,0 +1,92 @@
+package msgmorph
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+)
+
+// SortOrder is the direction in which list results are sorted.
+type SortOrder string
+
+// Sort orders for list endpoints.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Page is a single page of results returned by a list endpoint.
+//
+// Use NextCursor (or an offset) to request the following page, or use the
+// resource's All method to iterate over every page automatically.
+type Page[T any] struct {
+	// Data contains the items in this page.
+	Data []T `json:"data"`
+
+	// NextCursor is the cursor to pass to fetch the next page.
+	// Empty if the API uses offset pagination or there are no more pages.
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// HasMore indicates whether more results are available after this page.
+	HasMore bool `json:"hasMore"`
+
+	// Total is the total number of matching items, if reported by the API.
+	Total int `json:"total,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// In addition to the paginated object form, it accepts a bare JSON array,
+// which is treated as a single, complete page.
+func (p *Page[T]) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return err
+		}
+		*p = Page[T]{Data: items, Total: len(items)}
+		return nil
+	}
+
+	type page Page[T]
+	var decoded page
+	if err := json.Unmarshal(trimmed, &decoded); err != nil {
+		return err
+	}
+	*p = Page[T](decoded)
+	return nil
+}
+
+// paginate returns an iterator that lazily fetches pages using fetch,
+// starting at the given cursor and offset, and yields every item in turn.
+//
+// Iteration stops at the first error, which is yielded with the zero value.
+func paginate[T any](cursor string, offset int, fetch func(cursor string, offset int) (*Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := fetch(cursor, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !page.HasMore || len(page.Data) == 0 {
+				return
+			}
+
+			if page.NextCursor != "" {
+				cursor = page.NextCursor
+			} else {
+				offset += len(page.Data)
+			}
+		}
+	}
+}
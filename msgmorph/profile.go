@@ -0,0 +1,353 @@
+package msgmorph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Environment variables used to select a configuration profile.
+const (
+	// EnvProfile holds the name of the profile to use.
+	EnvProfile = "MSGMORPH_PROFILE"
+
+	// EnvConfig holds the path of the configuration file, overriding
+	// DefaultConfigPath.
+	EnvConfig = "MSGMORPH_CONFIG"
+)
+
+// Config is the contents of a MsgMorph configuration file.
+//
+// The file is JSON and holds named profiles, such as one per environment:
+//
+//	{
+//	  "defaultProfile": "local",
+//	  "profiles": {
+//	    "local": {
+//	      "baseUrl": "http://localhost:3001",
+//	      "organizationId": "org_dev",
+//	      "projectId": "proj_dev",
+//	      "apiKeyEnv": "MSGMORPH_LOCAL_API_KEY"
+//	    },
+//	    "production": {
+//	      "organizationId": "org_live",
+//	      "apiKeyFile": "~/.secrets/msgmorph-production",
+//	      "timeout": "60s"
+//	    }
+//	  }
+//	}
+type Config struct {
+	// DefaultProfile is the profile used when none is selected.
+	DefaultProfile string `json:"defaultProfile,omitempty"`
+
+	// Profiles holds the profiles by name.
+	Profiles map[string]*Profile `json:"profiles"`
+}
+
+// Profile is a named client configuration.
+//
+// The API key is best kept out of the configuration file: set APIKeyEnv to
+// the name of an environment variable holding it, or APIKeyFile to the path
+// of a file containing it. APIKey, if set, takes precedence over both.
+type Profile struct {
+	// Name is the profile's name in the configuration file.
+	Name string `json:"-"`
+
+	// BaseURL is the API base URL. Empty means DefaultBaseURL.
+	BaseURL string `json:"baseUrl,omitempty"`
+
+	// OrganizationID is the MsgMorph organization ID.
+	OrganizationID string `json:"organizationId,omitempty"`
+
+	// ProjectID is the default project ID.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// APIKey is the API key.
+	APIKey string `json:"apiKey,omitempty"`
+
+	// APIKeyEnv is the name of the environment variable holding the API key.
+	APIKeyEnv string `json:"apiKeyEnv,omitempty"`
+
+	// APIKeyFile is the path of a file containing the API key. A leading
+	// "~/" refers to the user's home directory.
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+
+	// Timeout is the HTTP client timeout, either as a duration such as
+	// "45s" or as a number of seconds. Empty means DefaultTimeout.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the configuration file: the value of
+// MSGMORPH_CONFIG if set, or msgmorph/config.json in the user's
+// configuration directory (for example ~/.config/msgmorph/config.json on
+// Linux).
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", newError(fmt.Sprintf("Cannot locate the configuration directory: %v", err), 400, ErrInvalidConfiguration, nil)
+	}
+	return filepath.Join(dir, "msgmorph", "config.json"), nil
+}
+
+// LoadConfig reads the configuration file at path.
+//
+// Errors:
+//   - ErrInvalidConfiguration: If the file cannot be read or parsed
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, newError(fmt.Sprintf("Cannot read configuration file: %v", err), 400, ErrInvalidConfiguration, nil)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, newError(fmt.Sprintf("Cannot parse configuration file %s: %v", path, err), 400, ErrInvalidConfiguration, nil)
+	}
+	for name, profile := range cfg.Profiles {
+		if profile == nil {
+			profile = &Profile{}
+			cfg.Profiles[name] = profile
+		}
+		profile.Name = name
+	}
+	return &cfg, nil
+}
+
+// Profile returns the profile with the given name. An empty name selects the
+// default profile.
+//
+// Errors:
+//   - ErrInvalidConfiguration: If the profile does not exist
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	if name == "" {
+		return nil, newError(
+			fmt.Sprintf("No profile selected. Set %s or defaultProfile in the configuration file.", EnvProfile),
+			400,
+			ErrInvalidConfiguration,
+			nil,
+		)
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(c.Profiles))
+		for n := range c.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, newError(
+			fmt.Sprintf("Profile %q not found. Available profiles: %s.", name, strings.Join(names, ", ")),
+			400,
+			ErrInvalidConfiguration,
+			nil,
+		)
+	}
+	return profile, nil
+}
+
+// LoadProfile loads a profile from the configuration file at
+// DefaultConfigPath.
+//
+// An empty name selects the profile named by MSGMORPH_PROFILE, or else the
+// file's default profile.
+//
+// Example:
+//
+//	profile, err := msgmorph.LoadProfile("staging")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := profile.NewClient()
+//
+// Errors:
+//   - ErrInvalidConfiguration: If the file cannot be read or the profile does not exist
+func LoadProfile(name string) (*Profile, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	return cfg.Profile(name)
+}
+
+// loadEnvProfile loads the profile selected by MSGMORPH_PROFILE or the
+// configuration file's default profile. Returns nil if no profile is
+// selected, including when there is no configuration file.
+func loadEnvProfile() (*Profile, error) {
+	name := os.Getenv(EnvProfile)
+
+	path, err := DefaultConfigPath()
+	if err != nil {
+		if name == "" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if _, err := os.Stat(path); name == "" && errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" && cfg.DefaultProfile == "" {
+		return nil, nil
+	}
+	return cfg.Profile(name)
+}
+
+// ResolveAPIKey returns the profile's API key, reading it from the
+// environment variable or file the profile refers to. Returns an empty
+// string if the profile sets no API key.
+//
+// Errors:
+//   - ErrInvalidAPIKey: If the referenced variable is not set or the file cannot be read
+func (p *Profile) ResolveAPIKey() (string, error) {
+	switch {
+	case p.APIKey != "":
+		return p.APIKey, nil
+
+	case p.APIKeyEnv != "":
+		key := os.Getenv(p.APIKeyEnv)
+		if key == "" {
+			return "", newError(
+				fmt.Sprintf("Profile %q reads the API key from %s, which is not set.", p.Name, p.APIKeyEnv),
+				400,
+				ErrInvalidAPIKey,
+				nil,
+			)
+		}
+		return key, nil
+
+	case p.APIKeyFile != "":
+		path := p.APIKeyFile
+		if rest, ok := strings.CutPrefix(path, "~/"); ok {
+			if home, err := os.UserHomeDir(); err == nil {
+				path = filepath.Join(home, rest)
+			}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", newError(
+				fmt.Sprintf("Profile %q cannot read the API key file: %v", p.Name, err),
+				400,
+				ErrInvalidAPIKey,
+				nil,
+			)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	return "", nil
+}
+
+// Options returns the client options set by the profile: the base URL,
+// default project ID and timeout.
+//
+// Errors:
+//   - ErrInvalidConfiguration: If the timeout is invalid
+func (p *Profile) Options() ([]ClientOption, error) {
+	var opts []ClientOption
+
+	if p.BaseURL != "" {
+		opts = append(opts, WithBaseURL(p.BaseURL))
+	}
+	if p.ProjectID != "" {
+		opts = append(opts, WithProjectID(p.ProjectID))
+	}
+	if p.Timeout != "" {
+		timeout, err := parseTimeout(p.Timeout)
+		if err != nil {
+			return opts, newError(
+				fmt.Sprintf("Timeout %q is not valid. Use a duration such as \"30s\" or a number of seconds.", p.Timeout),
+				400,
+				ErrInvalidConfiguration,
+				nil,
+			)
+		}
+		opts = append(opts, WithTimeout(timeout))
+	}
+
+	return opts, nil
+}
+
+// NewClient creates a client configured from the profile. Options passed to
+// NewClient take precedence over the profile.
+//
+// The profile's timeout applies to the default HTTP client. A client set with
+// WithHTTPClient is used as is and keeps its own timeout.
+//
+// The configuration is validated as by NewClientE.
+//
+// Returns a configured Client, or an error joining every configuration
+// problem found. Each problem is an *Error.
+func (p *Profile) NewClient(opts ...ClientOption) (*Client, error) {
+	var errs []error
+
+	apiKey, keyErr := p.ResolveAPIKey()
+	if keyErr != nil {
+		errs = append(errs, keyErr)
+	}
+
+	profileOpts, err := p.Options()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	c := newClient(apiKey, p.OrganizationID, append(profileOpts, opts...))
+	for _, err := range c.validate() {
+		// The key error above is more precise than "API key is required".
+		if keyErr != nil && err.Code == ErrInvalidAPIKey {
+			continue
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return c, nil
+}
+
+// NewClientFromProfile creates a client configured from the named profile in
+// the configuration file. An empty name selects the profile named by
+// MSGMORPH_PROFILE, or else the file's default profile.
+//
+// Unlike NewClientFromEnv, the MSGMORPH_* variables do not override the
+// profile. Options passed to NewClientFromProfile take precedence over it.
+//
+// Example:
+//
+//	client, err := msgmorph.NewClientFromProfile("staging")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewClientFromProfile(name string, opts ...ClientOption) (*Client, error) {
+	profile, err := LoadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	return profile.NewClient(opts...)
+}
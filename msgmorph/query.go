@@ -0,0 +1,131 @@
+package msgmorph
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// encodeQuery encodes a params struct into URL query values using the `url`
+// struct tags of its fields.
+//
+// The tag holds the query parameter name, optionally followed by
+// ",omitempty" to skip zero values. Fields without a tag, or tagged "-", are
+// ignored. Supported field types are strings, bools, integers, floats,
+// time.Time (encoded as RFC 3339), pointers to these (nil pointers are always
+// skipped) and slices of these (encoded as repeated parameters).
+func encodeQuery(params interface{}) (url.Values, error) {
+	values := url.Values{}
+
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return values, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("query params must be a struct, got %s", v.Type())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		omitEmpty := opts == "omitempty"
+
+		fv := v.Field(i)
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array {
+			for j := 0; j < fv.Len(); j++ {
+				s, ok, err := formatQueryValue(fv.Index(j))
+				if err != nil {
+					return nil, fmt.Errorf("query param %q: %w", name, err)
+				}
+				if ok {
+					values.Add(name, s)
+				}
+			}
+			continue
+		}
+
+		s, ok, err := formatQueryValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("query param %q: %w", name, err)
+		}
+		if ok {
+			values.Set(name, s)
+		}
+	}
+
+	return values, nil
+}
+
+// formatQueryValue formats a single value for a query string.
+// Returns false if the value is a nil pointer and should be skipped.
+func formatQueryValue(v reflect.Value) (string, bool, error) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// withQuery appends the query string encoded from params to path.
+func withQuery(path string, params interface{}) (string, error) {
+	values, err := encodeQuery(params)
+	if err != nil {
+		return "", newError(fmt.Sprintf("failed to encode query parameters: %v", err), 0, ErrValidationError, nil)
+	}
+	if len(values) == 0 {
+		return path, nil
+	}
+	return path + "?" + values.Encode(), nil
+}
+
+// pathSegment escapes a value, such as a resource ID, for safe use as a
+// single URL path segment.
+//
+// Errors:
+//   - ErrInvalidRequest: If s is empty, "." or "..", which would address
+//     another route
+func pathSegment(s string) (string, error) {
+	switch s {
+	case "", ".", "..":
+		return "", newError(fmt.Sprintf("Invalid ID %q.", s), 0, ErrInvalidRequest, nil)
+	}
+	return url.PathEscape(s), nil
+}
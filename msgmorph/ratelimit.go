@@ -0,0 +1,73 @@
+package msgmorph
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit describes the rate limit state reported by the API through the
+// X-RateLimit-* response headers.
+type RateLimit struct {
+	// Limit is the maximum number of requests allowed in the current window.
+	Limit int `json:"limit"`
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int `json:"remaining"`
+
+	// Reset is the time at which the current window resets.
+	// It is the zero time if the API did not report it.
+	Reset time.Time `json:"reset,omitempty"`
+}
+
+// parseRateLimit extracts rate limit information from response headers.
+// Returns nil if none of the X-RateLimit-* headers are present.
+func parseRateLimit(header http.Header, now time.Time) *RateLimit {
+	limit := header.Get("X-RateLimit-Limit")
+	remaining := header.Get("X-RateLimit-Remaining")
+	reset := header.Get("X-RateLimit-Reset")
+	if limit == "" && remaining == "" && reset == "" {
+		return nil
+	}
+
+	rl := &RateLimit{}
+	rl.Limit, _ = strconv.Atoi(strings.TrimSpace(limit))
+	rl.Remaining, _ = strconv.Atoi(strings.TrimSpace(remaining))
+
+	if n, err := strconv.ParseInt(strings.TrimSpace(reset), 10, 64); err == nil && n > 0 {
+		// Small values are a number of seconds until the reset; large values
+		// are a Unix timestamp.
+		if n < 1_000_000_000 {
+			rl.Reset = now.Add(time.Duration(n) * time.Second)
+		} else {
+			rl.Reset = time.Unix(n, 0)
+		}
+	}
+
+	return rl
+}
+
+// parseRetryAfter parses the Retry-After header, which holds either a number
+// of seconds or an HTTP date. Returns 0 if the header is missing or invalid.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,239 @@
+package msgmorph
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IdempotencyKeyHeader is the header carrying a request's idempotency key.
+//
+// The API processes a mutating request with a given key at most once and
+// replays the original response to later requests with the same key, so a
+// request can be retried without creating duplicates.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RequestOption is a function that configures a single API call, overriding
+// the client's settings for that call only.
+//
+// Request options are passed as the last arguments of resource methods:
+//
+//	contact, err := client.Contacts.Create(ctx, input,
+//	    msgmorph.WithIdempotencyKey("signup-user-123"),
+//	    msgmorph.WithRequestTimeout(5 * time.Second),
+//	)
+//
+// Methods that make several API calls, such as All, Upsert, and the bulk and
+// export methods, apply the options to each call.
+type RequestOption func(*requestConfig)
+
+// requestConfig holds the settings applied by RequestOptions.
+type requestConfig struct {
+	// idempotencyKey is sent in the Idempotency-Key header. Empty means a
+	// key is generated when needed.
+	idempotencyKey string
+
+	// header holds extra headers sent with the request.
+	header http.Header
+
+	// timeout bounds the call, including retries. Zero means no limit
+	// beyond the client's.
+	timeout time.Duration
+
+	// baseURL overrides the client's base URL.
+	baseURL string
+
+	// organizationID overrides the client's organization ID.
+	organizationID string
+
+	// retryPolicy overrides the client's retry policy.
+	retryPolicy *RetryPolicy
+}
+
+// newRequestConfig applies opts to a new requestConfig.
+func newRequestConfig(opts []RequestOption) requestConfig {
+	var cfg requestConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithIdempotencyKey sets the idempotency key sent with a mutating request.
+//
+// Requests with an idempotency key are retried on server errors (5xx) like
+// idempotent requests, according to the client's retry policy. Use a key
+// derived from your own data, such as a job or event ID, to make the call
+// safe to repeat across process restarts.
+//
+// Without this option, a random key is generated for each POST and PATCH
+// call when retries are enabled. The key is reported in Error.IdempotencyKey.
+//
+// The key is only sent with mutating requests; it is ignored for GET, HEAD
+// and OPTIONS requests.
+//
+// Example:
+//
+//	contact, err := client.Contacts.Create(ctx, input,
+//	    msgmorph.WithIdempotencyKey("signup-"+input.ExternalID),
+//	)
+func WithIdempotencyKey(key string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.idempotencyKey = key
+	}
+}
+
+// WithRequestHeader sets a header sent with the call, replacing any value
+// set by the client.
+//
+// Example:
+//
+//	contact, err := client.Contacts.Get(ctx, "cnt_abc123",
+//	    msgmorph.WithRequestHeader("X-Request-Id", requestID),
+//	)
+func WithRequestHeader(key, value string) RequestOption {
+	return func(cfg *requestConfig) {
+		if cfg.header == nil {
+			cfg.header = make(http.Header)
+		}
+		cfg.header.Set(key, value)
+	}
+}
+
+// WithRequestTimeout bounds the time taken by the call, including retries.
+//
+// The client's timeout, set with WithTimeout, still applies to each attempt.
+//
+// Example:
+//
+//	contacts, err := client.Contacts.List(ctx, params,
+//	    msgmorph.WithRequestTimeout(2 * time.Second),
+//	)
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithRequestBaseURL sends the call to a different API URL.
+//
+// Example:
+//
+//	contact, err := client.Contacts.Get(ctx, "cnt_abc123",
+//	    msgmorph.WithRequestBaseURL("https://eu.api.msgmorph.com/"),
+//	)
+func WithRequestBaseURL(url string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.baseURL = url
+	}
+}
+
+// WithRequestOrganization makes the call on behalf of another organization,
+// so that one client can serve several organizations with the same API key.
+//
+// Example:
+//
+//	contacts, err := client.Contacts.List(ctx, params,
+//	    msgmorph.WithRequestOrganization(tenant.OrganizationID),
+//	)
+func WithRequestOrganization(organizationID string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.organizationID = organizationID
+	}
+}
+
+// WithRequestRetryPolicy sets the retry policy used for the call.
+//
+// Example:
+//
+//	// Do not retry this call
+//	err := client.Contacts.Delete(ctx, "cnt_abc123",
+//	    msgmorph.WithRequestRetryPolicy(msgmorph.RetryPolicy{MaxAttempts: 1}),
+//	)
+func WithRequestRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.retryPolicy = &policy
+	}
+}
+
+// withIdempotencyKeySuffix derives the key of a follow-up request from the
+// key set by the caller, if any, so that each request made by a composite
+// operation such as Upsert has its own key.
+func withIdempotencyKeySuffix(suffix string) RequestOption {
+	return func(cfg *requestConfig) {
+		if cfg.idempotencyKey != "" {
+			cfg.idempotencyKey += ":" + suffix
+		}
+	}
+}
+
+// withSuffixedIdempotencyKey returns opts followed by
+// withIdempotencyKeySuffix(suffix), leaving the caller's slice untouched.
+func withSuffixedIdempotencyKey(opts []RequestOption, suffix string) []RequestOption {
+	return append(opts[:len(opts):len(opts)], withIdempotencyKeySuffix(suffix))
+}
+
+// withoutIdempotencyKey returns opts followed by an option removing the
+// idempotency key, leaving the caller's slice untouched. It is used for the
+// lookups made by composite operations such as Upsert.
+func withoutIdempotencyKey(opts []RequestOption) []RequestOption {
+	return append(opts[:len(opts):len(opts)], func(cfg *requestConfig) {
+		cfg.idempotencyKey = ""
+	})
+}
+
+// apply sets up req and ctx for a call configured by cfg. The returned
+// cancel function must be called once the call completes.
+func (cfg requestConfig) apply(ctx context.Context, c *Client, req *Request) (context.Context, context.CancelFunc) {
+	req.baseURL = c.baseURL
+	if cfg.baseURL != "" {
+		req.baseURL = cfg.baseURL
+	}
+
+	req.retryPolicy = c.retryPolicy
+	if cfg.retryPolicy != nil {
+		req.retryPolicy = *cfg.retryPolicy
+	}
+
+	if cfg.organizationID != "" {
+		req.Header.Set("X-Organization-Id", cfg.organizationID)
+	}
+	for key, values := range cfg.header {
+		req.Header[key] = values
+	}
+
+	switch {
+	case !isMutating(req.Method):
+		// Idempotency keys only apply to mutating requests.
+	case cfg.idempotencyKey != "":
+		req.Header.Set(IdempotencyKeyHeader, cfg.idempotencyKey)
+	case (req.Method == http.MethodPost || req.Method == http.MethodPatch) && req.retryPolicy.MaxAttempts > 1:
+		req.Header.Set(IdempotencyKeyHeader, newIdempotencyKey())
+	}
+
+	if cfg.timeout > 0 {
+		return context.WithTimeout(ctx, cfg.timeout)
+	}
+	return ctx, func() {}
+}
+
+// isMutating reports whether an HTTP method may change state on the server.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// newIdempotencyKey returns a random (version 4) UUID.
+func newIdempotencyKey() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
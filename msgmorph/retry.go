@@ -0,0 +1,174 @@
+package msgmorph
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how the client retries failed requests.
+//
+// Requests are retried when they fail with a temporary network error, such as
+// a timeout or refused connection (see Error.IsTemporary), or, for idempotent
+// methods (GET, PUT, DELETE, HEAD, OPTIONS) and requests carrying an
+// idempotency key, with a server error (5xx). Cancellations, TLS errors and
+// unknown hosts are not retried. The delay between attempts grows
+// exponentially from InitialBackoff up to MaxBackoff, with random jitter
+// applied to avoid synchronized retries.
+//
+// When RespectRetryAfter is set, rate limited requests (429) are retried as
+// well, and the client waits at least as long as the API's Retry-After header
+// asks before the next attempt.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithRetryPolicy(msgmorph.RetryPolicy{
+//	        MaxAttempts:       5,
+//	        InitialBackoff:    200 * time.Millisecond,
+//	        MaxBackoff:        10 * time.Second,
+//	        Multiplier:        2,
+//	        Jitter:            0.2,
+//	        RespectRetryAfter: true,
+//	        MaxRetryAfter:     time.Minute,
+//	    }),
+//	)
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor by which the delay grows after each attempt.
+	// Values below 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay that is randomized, between 0 and 1.
+	// For example, 0.2 spreads a 1s delay over the range 0.8s to 1.2s.
+	Jitter float64
+
+	// RespectRetryAfter enables retrying rate limited (429) requests and
+	// waiting for the delay requested by the Retry-After header.
+	RespectRetryAfter bool
+
+	// MaxRetryAfter is the longest Retry-After delay the client will wait.
+	// If the API asks for a longer delay, the error is returned immediately.
+	// Zero means no limit.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most applications:
+// up to 3 attempts with exponential backoff starting at 500ms, honouring
+// Retry-After delays of up to 30 seconds.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    500 * time.Millisecond,
+		MaxBackoff:        10 * time.Second,
+		Multiplier:        2,
+		Jitter:            0.2,
+		RespectRetryAfter: true,
+		MaxRetryAfter:     30 * time.Second,
+	}
+}
+
+// noRetryPolicy is the policy used when no retry policy is configured.
+var noRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy enables automatic retries using the given policy.
+//
+// By default the client makes a single attempt per request.
+//
+// Example:
+//
+//	client := msgmorph.NewClient(apiKey, orgID,
+//	    msgmorph.WithRetryPolicy(msgmorph.DefaultRetryPolicy()),
+//	)
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// shouldRetry reports whether a request that failed with err may be retried.
+// Only temporary errors are retried (see Error.IsTemporary), and server
+// errors only for idempotent requests.
+func (p RetryPolicy) shouldRetry(idempotent bool, err *Error) bool {
+	if !err.IsTemporary() {
+		return false
+	}
+	if err.RetryAfter > 0 && p.RespectRetryAfter && p.MaxRetryAfter > 0 && err.RetryAfter > p.MaxRetryAfter {
+		return false
+	}
+	if err.IsRateLimited() {
+		return p.RespectRetryAfter
+	}
+	if err.Status >= 500 {
+		return idempotent
+	}
+	return true
+}
+
+// delay returns how long to wait after the given (1-based) attempt failed
+// with err, taking the API's Retry-After header into account.
+func (p RetryPolicy) delay(attempt int, err *Error) time.Duration {
+	d := p.backoff(attempt)
+	if p.RespectRetryAfter && err.RetryAfter > d {
+		d = err.RetryAfter
+	}
+	return d
+}
+
+// backoff returns the delay to wait after the given (1-based) attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay += delay * jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
+
+// isIdempotent reports whether an HTTP method can safely be repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleepContext waits for the given duration or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+package msgmorph
+
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+)
+
+// ContactsService is the interface implemented by ContactsResource.
+//
+// Depend on ContactsService instead of *ContactsResource to substitute a
+// mock, such as msgmorphtest.MockContacts, in unit tests:
+//
+//	type Syncer struct {
+//	    Contacts msgmorph.ContactsService
+//	}
+//
+//	syncer := &Syncer{Contacts: client.Contacts}
+type ContactsService interface {
+	Create(ctx context.Context, input CreateContactInput, opts ...RequestOption) (*Contact, error)
+	List(ctx context.Context, params ListContactsParams, opts ...RequestOption) ([]Contact, error)
+	ListPage(ctx context.Context, params ListContactsParams, opts ...RequestOption) (*ContactPage, error)
+	All(ctx context.Context, params ListContactsParams, opts ...RequestOption) iter.Seq2[Contact, error]
+	Get(ctx context.Context, id string, opts ...RequestOption) (*Contact, error)
+	GetByExternalID(ctx context.Context, projectID, externalID string, opts ...RequestOption) (*Contact, error)
+	Update(ctx context.Context, id string, input UpdateContactInput, opts ...RequestOption) (*Contact, error)
+	Upsert(ctx context.Context, input CreateContactInput, opts ...RequestOption) (*Contact, bool, error)
+	Delete(ctx context.Context, id string, opts ...RequestOption) error
+	SendFeedbackRequest(ctx context.Context, id string, input SendFeedbackRequestInput, opts ...RequestOption) (*Contact, error)
+	ScheduleFeedback(ctx context.Context, id string, at time.Time, opts ...RequestOption) (*Contact, error)
+	CancelScheduledFeedback(ctx context.Context, id string, opts ...RequestOption) (*Contact, error)
+	BulkCreate(ctx context.Context, inputs []CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error)
+	BulkCreateStream(ctx context.Context, inputs <-chan CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error)
+	BulkUpsert(ctx context.Context, inputs []CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error)
+	BulkUpsertStream(ctx context.Context, inputs <-chan CreateContactInput, opts BulkOptions, reqOpts ...RequestOption) (*BulkResult, error)
+	ExportCSV(ctx context.Context, w io.Writer, params ListContactsParams, opts ExportOptions, reqOpts ...RequestOption) error
+	ExportNDJSON(ctx context.Context, w io.Writer, params ListContactsParams, opts ExportOptions, reqOpts ...RequestOption) error
+}
+
+// FeedbackService is the interface implemented by FeedbackResource.
+type FeedbackService interface {
+	List(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) ([]FeedbackResponse, error)
+	ListPage(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) (*FeedbackPage, error)
+	All(ctx context.Context, params ListFeedbackParams, opts ...RequestOption) iter.Seq2[FeedbackResponse, error]
+	Get(ctx context.Context, id string, opts ...RequestOption) (*FeedbackResponse, error)
+	Export(ctx context.Context, w io.Writer, params ListFeedbackParams, opts ...RequestOption) error
+}
+
+// WebhooksService is the interface implemented by WebhooksResource.
+type WebhooksService interface {
+	Create(ctx context.Context, input CreateWebhookInput, opts ...RequestOption) (*WebhookEndpoint, error)
+	List(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) ([]WebhookEndpoint, error)
+	ListPage(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) (*WebhookEndpointPage, error)
+	All(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) iter.Seq2[WebhookEndpoint, error]
+	Get(ctx context.Context, id string, opts ...RequestOption) (*WebhookEndpoint, error)
+	Update(ctx context.Context, id string, input UpdateWebhookInput, opts ...RequestOption) (*WebhookEndpoint, error)
+	RotateSecret(ctx context.Context, id string, opts ...RequestOption) (*WebhookEndpoint, error)
+	Delete(ctx context.Context, id string, opts ...RequestOption) error
+}
+
+var (
+	_ ContactsService = (*ContactsResource)(nil)
+	_ FeedbackService = (*FeedbackResource)(nil)
+	_ WebhooksService = (*WebhooksResource)(nil)
+)
@@ -50,7 +50,8 @@ type CreateContactInput struct {
 	// Name is the contact's display name (optional).
 	Name string `json:"name,omitempty"`
 
-	// ProjectID is the MsgMorph project ID to associate this contact with (required).
+	// ProjectID is the MsgMorph project ID to associate this contact with.
+	// Required unless the client has a default project (see WithProjectID).
 	ProjectID string `json:"projectId"`
 }
 
@@ -64,12 +65,71 @@ type UpdateContactInput struct {
 	Name string `json:"name,omitempty"`
 }
 
+// SendFeedbackRequestInput contains the options for sending a feedback
+// request to a contact immediately.
+type SendFeedbackRequestInput struct {
+	// Channel is the channel to send the request through (optional).
+	// The project's default channel is used when empty.
+	Channel FeedbackChannel `json:"channel,omitempty"`
+}
+
+// scheduleFeedbackInput is the request body for scheduling feedback.
+type scheduleFeedbackInput struct {
+	ScheduledAt time.Time `json:"scheduledAt"`
+}
+
+// ContactSortField is a field by which contacts can be sorted.
+type ContactSortField string
+
+// Fields by which contacts can be sorted.
+const (
+	ContactSortCreatedAt ContactSortField = "createdAt"
+	ContactSortUpdatedAt ContactSortField = "updatedAt"
+	ContactSortEmail     ContactSortField = "email"
+	ContactSortName      ContactSortField = "name"
+)
+
 // ListContactsParams contains the parameters for listing contacts.
 type ListContactsParams struct {
-	// ProjectID filters contacts by project ID (required).
+	// ProjectID filters contacts by project ID.
+	// Required unless the client has a default project (see WithProjectID).
 	ProjectID string `url:"projectId"`
+
+	// Limit is the maximum number of contacts to return per page (optional).
+	// The API applies its own default and maximum when unset.
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor is the cursor returned as NextCursor by a previous page (optional).
+	Cursor string `url:"cursor,omitempty"`
+
+	// Offset is the number of contacts to skip, for offset pagination (optional).
+	Offset int `url:"offset,omitempty"`
+
+	// SortBy is the field to sort contacts by (optional).
+	SortBy ContactSortField `url:"sortBy,omitempty"`
+
+	// SortOrder is the sort direction (optional).
+	SortOrder SortOrder `url:"sortOrder,omitempty"`
+
+	// Email filters contacts by email address (optional).
+	Email string `url:"email,omitempty"`
+
+	// ExternalID filters contacts by your system's user ID (optional).
+	ExternalID string `url:"externalId,omitempty"`
+
+	// FeedbackSent filters contacts by whether feedback has been sent (optional).
+	FeedbackSent *bool `url:"feedbackSent,omitempty"`
+
+	// CreatedAfter only includes contacts created after this time (optional).
+	CreatedAfter *time.Time `url:"createdAfter,omitempty"`
+
+	// CreatedBefore only includes contacts created before this time (optional).
+	CreatedBefore *time.Time `url:"createdBefore,omitempty"`
 }
 
+// ContactPage is a single page of contacts.
+type ContactPage = Page[Contact]
+
 // APIResponse is the standard response wrapper from the MsgMorph API.
 type APIResponse[T any] struct {
 	// Data contains the response payload.
@@ -78,3 +138,189 @@ type APIResponse[T any] struct {
 	// Error contains an error message if the request failed.
 	Error string `json:"error,omitempty"`
 }
+
+// FeedbackChannel is the channel through which feedback was collected.
+type FeedbackChannel string
+
+// Channels through which feedback can be collected.
+const (
+	FeedbackChannelEmail FeedbackChannel = "email"
+	FeedbackChannelInApp FeedbackChannel = "in_app"
+	FeedbackChannelLink  FeedbackChannel = "link"
+	FeedbackChannelSMS   FeedbackChannel = "sms"
+)
+
+// FeedbackAnswer is a contact's answer to a single feedback question.
+type FeedbackAnswer struct {
+	// QuestionID is the unique identifier of the question.
+	QuestionID string `json:"questionId"`
+
+	// Question is the question text as it was shown to the contact.
+	Question string `json:"question,omitempty"`
+
+	// Answer is the contact's free-text answer.
+	Answer string `json:"answer"`
+}
+
+// FeedbackResponse represents feedback submitted by a contact.
+type FeedbackResponse struct {
+	// ID is the unique identifier for the feedback response in MsgMorph.
+	ID string `json:"id"`
+
+	// ProjectID is the MsgMorph project ID the response belongs to.
+	ProjectID string `json:"projectId"`
+
+	// ContactID is the ID of the contact who submitted the response.
+	ContactID string `json:"contactId"`
+
+	// Score is the rating given by the contact. May be nil if the survey
+	// did not ask for a score or the contact skipped it.
+	Score *int `json:"score"`
+
+	// Answers contains the contact's free-text answers.
+	Answers []FeedbackAnswer `json:"answers"`
+
+	// Channel is the channel through which the feedback was collected.
+	Channel FeedbackChannel `json:"channel"`
+
+	// SubmittedAt is the timestamp when the contact submitted the feedback.
+	SubmittedAt time.Time `json:"submittedAt"`
+
+	// CreatedAt is the timestamp when the response was recorded.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ListFeedbackParams contains the parameters for listing feedback responses.
+type ListFeedbackParams struct {
+	// ProjectID filters responses by project ID.
+	// Required unless the client has a default project (see WithProjectID).
+	ProjectID string `url:"projectId"`
+
+	// ContactID filters responses by contact (optional).
+	ContactID string `url:"contactId,omitempty"`
+
+	// Channel filters responses by collection channel (optional).
+	Channel FeedbackChannel `url:"channel,omitempty"`
+
+	// SubmittedAfter only includes responses submitted after this time (optional).
+	SubmittedAfter *time.Time `url:"submittedAfter,omitempty"`
+
+	// SubmittedBefore only includes responses submitted before this time (optional).
+	SubmittedBefore *time.Time `url:"submittedBefore,omitempty"`
+
+	// MinScore only includes responses with at least this score (optional).
+	MinScore *int `url:"minScore,omitempty"`
+
+	// MaxScore only includes responses with at most this score (optional).
+	MaxScore *int `url:"maxScore,omitempty"`
+
+	// Limit is the maximum number of responses to return per page (optional).
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor is the cursor returned as NextCursor by a previous page (optional).
+	Cursor string `url:"cursor,omitempty"`
+
+	// Offset is the number of responses to skip, for offset pagination (optional).
+	Offset int `url:"offset,omitempty"`
+
+	// SortOrder is the sort direction by submission time (optional).
+	SortOrder SortOrder `url:"sortOrder,omitempty"`
+}
+
+// FeedbackPage is a single page of feedback responses.
+type FeedbackPage = Page[FeedbackResponse]
+
+// WebhookEventType identifies the kind of event delivered to a webhook
+// endpoint.
+type WebhookEventType string
+
+// Webhook event types.
+const (
+	WebhookEventFeedbackSubmitted   WebhookEventType = "feedback.submitted"
+	WebhookEventFeedbackRequestSent WebhookEventType = "feedback_request.sent"
+	WebhookEventContactDeleted      WebhookEventType = "contact.deleted"
+)
+
+// WebhookEndpoint represents a URL that receives webhook events.
+type WebhookEndpoint struct {
+	// ID is the unique identifier for the endpoint in MsgMorph.
+	ID string `json:"id"`
+
+	// URL is the HTTPS URL events are delivered to.
+	URL string `json:"url"`
+
+	// Description is a human-readable description of the endpoint.
+	Description string `json:"description"`
+
+	// ProjectID restricts the endpoint to events from a single project.
+	// Empty if the endpoint receives events from every project.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// Events are the event types delivered to the endpoint.
+	Events []WebhookEventType `json:"events"`
+
+	// Enabled indicates whether events are currently delivered.
+	Enabled bool `json:"enabled"`
+
+	// Secret is the signing secret used to verify deliveries. It is only
+	// returned when the endpoint is created or its secret is rotated.
+	Secret string `json:"secret,omitempty"`
+
+	// CreatedAt is the timestamp when the endpoint was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the timestamp when the endpoint was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CreateWebhookInput contains the parameters for creating a webhook endpoint.
+type CreateWebhookInput struct {
+	// URL is the HTTPS URL to deliver events to (required).
+	URL string `json:"url"`
+
+	// Events are the event types to deliver (required).
+	Events []WebhookEventType `json:"events"`
+
+	// Description is a human-readable description of the endpoint (optional).
+	Description string `json:"description,omitempty"`
+
+	// ProjectID restricts the endpoint to events from a single project (optional).
+	ProjectID string `json:"projectId,omitempty"`
+
+	// Disabled creates the endpoint without delivering events to it (optional).
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// UpdateWebhookInput contains the parameters for updating a webhook endpoint.
+// All fields are optional; only provided fields will be updated.
+type UpdateWebhookInput struct {
+	// URL is the new delivery URL.
+	URL string `json:"url,omitempty"`
+
+	// Events replaces the event types delivered to the endpoint.
+	Events []WebhookEventType `json:"events,omitempty"`
+
+	// Description is the new description.
+	Description string `json:"description,omitempty"`
+
+	// Enabled enables or disables delivery.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ListWebhooksParams contains the parameters for listing webhook endpoints.
+type ListWebhooksParams struct {
+	// ProjectID filters endpoints by project ID (optional).
+	ProjectID string `url:"projectId,omitempty"`
+
+	// Limit is the maximum number of endpoints to return per page (optional).
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor is the cursor returned as NextCursor by a previous page (optional).
+	Cursor string `url:"cursor,omitempty"`
+
+	// Offset is the number of endpoints to skip, for offset pagination (optional).
+	Offset int `url:"offset,omitempty"`
+}
+
+// WebhookEndpointPage is a single page of webhook endpoints.
+type WebhookEndpointPage = Page[WebhookEndpoint]
@@ -0,0 +1,280 @@
+package msgmorph
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// WebhooksResource provides methods to manage webhook endpoints.
+//
+// Webhook endpoints receive events such as submitted feedback. Use this
+// resource to create, list, get, update, rotate the secret of, and delete
+// endpoints. To receive and verify deliveries, see the webhooks package.
+//
+// Example usage:
+//
+//	// Create an endpoint
+//	endpoint, err := client.Webhooks.Create(ctx, msgmorph.CreateWebhookInput{
+//	    URL:    "https://example.com/webhooks/msgmorph",
+//	    Events: []msgmorph.WebhookEventType{msgmorph.WebhookEventFeedbackSubmitted},
+//	})
+//
+//	// List endpoints
+//	for endpoint, err := range client.Webhooks.All(ctx, msgmorph.ListWebhooksParams{}) {
+//	    // ...
+//	}
+//
+//	// Rotate an endpoint's signing secret
+//	endpoint, err = client.Webhooks.RotateSecret(ctx, "wh_abc123")
+//
+//	// Delete an endpoint
+//	err = client.Webhooks.Delete(ctx, "wh_abc123")
+type WebhooksResource struct {
+	client *Client
+}
+
+// Create creates a new webhook endpoint.
+//
+// The returned endpoint's Secret is only available in this response; store
+// it to verify deliveries.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - input: Endpoint creation parameters
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the created WebhookEndpoint or an error.
+//
+// Example:
+//
+//	endpoint, err := client.Webhooks.Create(ctx, msgmorph.CreateWebhookInput{
+//	    URL: "https://example.com/webhooks/msgmorph",
+//	    Events: []msgmorph.WebhookEventType{
+//	        msgmorph.WebhookEventFeedbackSubmitted,
+//	        msgmorph.WebhookEventFeedbackRequestSent,
+//	    },
+//	    Description: "Production feedback sync",
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Signing secret: %s\n", endpoint.Secret)
+//
+// Errors:
+//   - ErrValidationError: If the URL or event types are invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) Create(ctx context.Context, input CreateWebhookInput, opts ...RequestOption) (*WebhookEndpoint, error) {
+	var endpoint WebhookEndpoint
+	err := r.client.request(ctx, "webhooks.create", http.MethodPost, "/api/v1/webhooks", input, &endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// List retrieves a single page of the organization's webhook endpoints.
+//
+// Use ListPage to access pagination details, or All to iterate over every
+// endpoint.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - params: Query parameters for filtering and paginating endpoints
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns a slice of WebhookEndpoint objects or an error.
+//
+// Example:
+//
+//	endpoints, err := client.Webhooks.List(ctx, msgmorph.ListWebhooksParams{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, e := range endpoints {
+//	    fmt.Printf("Endpoint: %s (%v)\n", e.URL, e.Events)
+//	}
+//
+// Errors:
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) List(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) ([]WebhookEndpoint, error) {
+	page, err := r.ListPage(ctx, params, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page.Data, nil
+}
+
+// ListPage retrieves a single page of webhook endpoints along with
+// pagination details.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - params: Query parameters for filtering and paginating endpoints
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns a WebhookEndpointPage or an error.
+//
+// Errors:
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) ListPage(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) (*WebhookEndpointPage, error) {
+	path, err := withQuery("/api/v1/webhooks", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var page WebhookEndpointPage
+	err = r.client.request(ctx, "webhooks.list", http.MethodGet, path, nil, &page, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// All returns an iterator over every webhook endpoint matching params.
+//
+// Pages are fetched lazily as the iteration progresses. If a page cannot be
+// fetched, the error is yielded and the iteration stops.
+//
+// Example:
+//
+//	for endpoint, err := range client.Webhooks.All(ctx, msgmorph.ListWebhooksParams{}) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Printf("Endpoint: %s\n", endpoint.URL)
+//	}
+func (r *WebhooksResource) All(ctx context.Context, params ListWebhooksParams, opts ...RequestOption) iter.Seq2[WebhookEndpoint, error] {
+	return paginate(params.Cursor, params.Offset, func(cursor string, offset int) (*WebhookEndpointPage, error) {
+		params.Cursor = cursor
+		params.Offset = offset
+		return r.ListPage(ctx, params, opts...)
+	})
+}
+
+// Get retrieves a single webhook endpoint by ID.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The endpoint's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithRequestTimeout
+//
+// Returns the WebhookEndpoint or an error.
+//
+// Errors:
+//   - ErrNotFound: If the endpoint doesn't exist
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) Get(ctx context.Context, id string, opts ...RequestOption) (*WebhookEndpoint, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/webhooks/%s", segment)
+
+	var endpoint WebhookEndpoint
+	err = r.client.request(ctx, "webhooks.get", http.MethodGet, path, nil, &endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// Update modifies an existing webhook endpoint.
+//
+// Only the fields provided in the input will be updated.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The endpoint's unique ID in MsgMorph
+//   - input: Fields to update
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the updated WebhookEndpoint or an error.
+//
+// Example:
+//
+//	enabled := false
+//	endpoint, err := client.Webhooks.Update(ctx, "wh_abc123", msgmorph.UpdateWebhookInput{
+//	    Enabled: &enabled,
+//	})
+//
+// Errors:
+//   - ErrNotFound: If the endpoint doesn't exist
+//   - ErrValidationError: If the input is invalid
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) Update(ctx context.Context, id string, input UpdateWebhookInput, opts ...RequestOption) (*WebhookEndpoint, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/webhooks/%s", segment)
+
+	var endpoint WebhookEndpoint
+	err = r.client.request(ctx, "webhooks.update", http.MethodPatch, path, input, &endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// RotateSecret replaces an endpoint's signing secret.
+//
+// The returned endpoint's Secret holds the new secret. Update your webhook
+// receiver to use it.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The endpoint's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns the WebhookEndpoint with its new Secret or an error.
+//
+// Example:
+//
+//	endpoint, err := client.Webhooks.RotateSecret(ctx, "wh_abc123")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	storeSecret(endpoint.ID, endpoint.Secret)
+//
+// Errors:
+//   - ErrNotFound: If the endpoint doesn't exist
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) RotateSecret(ctx context.Context, id string, opts ...RequestOption) (*WebhookEndpoint, error) {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/api/v1/webhooks/%s/rotate-secret", segment)
+
+	var endpoint WebhookEndpoint
+	err = r.client.request(ctx, "webhooks.rotate_secret", http.MethodPost, path, nil, &endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// Delete removes a webhook endpoint.
+//
+// This operation is permanent and cannot be undone.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - id: The endpoint's unique ID in MsgMorph
+//   - opts: Optional request options, such as WithIdempotencyKey
+//
+// Returns nil on success or an error.
+//
+// Errors:
+//   - ErrNotFound: If the endpoint doesn't exist
+//   - ErrUnauthorized: If the API key is invalid
+func (r *WebhooksResource) Delete(ctx context.Context, id string, opts ...RequestOption) error {
+	segment, err := pathSegment(id)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/api/v1/webhooks/%s", segment)
+	return r.client.request(ctx, "webhooks.delete", http.MethodDelete, path, nil, nil, opts...)
+}
@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MHamzaAhmad/msgmorph-go-sdk/msgmorph"
+)
+
+// EventType identifies the kind of a webhook event.
+type EventType = msgmorph.WebhookEventType
+
+// Webhook event types.
+const (
+	// EventFeedbackSubmitted is sent when a contact submits feedback.
+	EventFeedbackSubmitted = msgmorph.WebhookEventFeedbackSubmitted
+
+	// EventFeedbackRequestSent is sent when a feedback request, immediate or
+	// scheduled, is delivered to a contact.
+	EventFeedbackRequestSent = msgmorph.WebhookEventFeedbackRequestSent
+
+	// EventContactDeleted is sent when a contact is deleted.
+	EventContactDeleted = msgmorph.WebhookEventContactDeleted
+)
+
+// Event is a webhook delivery as sent by MsgMorph.
+//
+// Data holds the event-specific payload, which the typed handlers decode
+// into FeedbackSubmitted, FeedbackRequestSent or ContactDeleted.
+type Event struct {
+	// ID is the unique identifier of the event. Redeliveries of the same
+	// event share the same ID.
+	ID string `json:"id"`
+
+	// Type is the kind of event.
+	Type EventType `json:"type"`
+
+	// OrganizationID is the MsgMorph organization the event belongs to.
+	OrganizationID string `json:"organizationId"`
+
+	// ProjectID is the MsgMorph project the event belongs to.
+	ProjectID string `json:"projectId"`
+
+	// CreatedAt is the timestamp when the event occurred.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data contains the raw event payload.
+	Data json.RawMessage `json:"data"`
+}
+
+// FeedbackSubmitted is the payload of an EventFeedbackSubmitted event.
+type FeedbackSubmitted struct {
+	// Contact is the contact who submitted the feedback.
+	Contact msgmorph.Contact `json:"contact"`
+
+	// Response is the submitted feedback.
+	Response msgmorph.FeedbackResponse `json:"response"`
+}
+
+// FeedbackRequestSent is the payload of an EventFeedbackRequestSent event.
+type FeedbackRequestSent struct {
+	// Contact is the contact the request was sent to, with FeedbackSent set.
+	Contact msgmorph.Contact `json:"contact"`
+
+	// Channel is the channel the request was sent through.
+	Channel msgmorph.FeedbackChannel `json:"channel"`
+
+	// Scheduled indicates whether the request had been scheduled in advance.
+	Scheduled bool `json:"scheduled"`
+
+	// SentAt is the timestamp when the request was sent.
+	SentAt time.Time `json:"sentAt"`
+}
+
+// ContactDeleted is the payload of an EventContactDeleted event.
+type ContactDeleted struct {
+	// Contact is the contact as it was before deletion.
+	Contact msgmorph.Contact `json:"contact"`
+}
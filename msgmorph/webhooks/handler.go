@@ -0,0 +1,291 @@
+// Package webhooks receives and verifies MsgMorph webhook deliveries.
+//
+// A Handler is an http.Handler that verifies each delivery's signature and
+// timestamp, rejects replayed deliveries, decodes the payload into a typed
+// event, and dispatches it to the handlers registered for its type.
+//
+// # Quick Start
+//
+//	handler, err := webhooks.NewHandler(os.Getenv("MSGMORPH_WEBHOOK_SECRET"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	handler.OnFeedbackSubmitted(func(ctx context.Context, event *webhooks.Event, data *webhooks.FeedbackSubmitted) error {
+//	    fmt.Printf("Contact %s submitted feedback %s\n", data.Contact.ID, data.Response.ID)
+//	    return nil
+//	})
+//
+//	http.Handle("/webhooks/msgmorph", handler)
+//	log.Fatal(http.ListenAndServe(":8080", nil))
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultMaxBodyBytes is the default maximum size of a webhook payload.
+const DefaultMaxBodyBytes = 1 << 20
+
+// ErrInvalidPayload is returned when a delivery's payload cannot be decoded,
+// or the event has no ID.
+var ErrInvalidPayload = errors.New("webhooks: invalid payload")
+
+// EventHandlerFunc handles a webhook event.
+//
+// Returning an error makes the Handler respond with a 500 status, so that
+// MsgMorph redelivers the event later.
+type EventHandlerFunc func(ctx context.Context, event *Event) error
+
+// Option is a function that configures a Handler.
+type Option func(*Handler)
+
+// WithTolerance sets the maximum age of a delivery's signature timestamp.
+// Defaults to DefaultTolerance. A value of zero or less disables the check.
+func WithTolerance(tolerance time.Duration) Option {
+	return func(h *Handler) {
+		h.tolerance = tolerance
+	}
+}
+
+// WithReplayStore sets the store used to detect replayed deliveries.
+// Defaults to an in-memory store. Use a shared store when running several
+// instances of your webhook receiver.
+func WithReplayStore(store ReplayStore) Option {
+	return func(h *Handler) {
+		h.replay = store
+	}
+}
+
+// WithMaxBodyBytes sets the maximum accepted payload size.
+// Defaults to DefaultMaxBodyBytes.
+func WithMaxBodyBytes(n int64) Option {
+	return func(h *Handler) {
+		h.maxBodyBytes = n
+	}
+}
+
+// WithErrorLog sets a function called with errors that occur while handling
+// deliveries, such as signature failures and handler errors.
+func WithErrorLog(fn func(err error)) Option {
+	return func(h *Handler) {
+		h.onError = fn
+	}
+}
+
+// Handler is an http.Handler that receives MsgMorph webhook deliveries.
+//
+// Use NewHandler to create a Handler, then register event handlers with On,
+// OnFeedbackSubmitted, OnFeedbackRequestSent and OnContactDeleted. Handlers
+// may be registered concurrently with serving requests.
+//
+// The Handler responds with:
+//   - 200 when the event was handled, has no registered handler, or is a replay
+//   - 400 when the payload, or the typed data passed to a handler, cannot be
+//     decoded, or the event has no ID
+//   - 401 when the signature or timestamp is invalid
+//   - 405 when the request is not a POST
+//   - 500 when a registered handler returns an error
+type Handler struct {
+	secret       string
+	tolerance    time.Duration
+	replay       ReplayStore
+	maxBodyBytes int64
+	onError      func(err error)
+
+	mu       sync.RWMutex
+	handlers map[EventType][]EventHandlerFunc
+}
+
+// NewHandler creates a Handler that verifies deliveries signed with secret.
+//
+// Returns ErrEmptySecret if secret is empty.
+//
+// Example:
+//
+//	handler, err := webhooks.NewHandler(
+//	    os.Getenv("MSGMORPH_WEBHOOK_SECRET"),
+//	    webhooks.WithTolerance(10 * time.Minute),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewHandler(secret string, opts ...Option) (*Handler, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+
+	h := &Handler{
+		secret:       secret,
+		tolerance:    DefaultTolerance,
+		replay:       NewMemoryReplayStore(),
+		maxBodyBytes: DefaultMaxBodyBytes,
+		handlers:     make(map[EventType][]EventHandlerFunc),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h, nil
+}
+
+// On registers fn to be called for events of the given type.
+// Handlers for the same type are called in registration order.
+func (h *Handler) On(eventType EventType, fn EventHandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[eventType] = append(h.handlers[eventType], fn)
+}
+
+// OnFeedbackSubmitted registers fn to be called when a contact submits
+// feedback.
+//
+// Example:
+//
+//	handler.OnFeedbackSubmitted(func(ctx context.Context, event *webhooks.Event, data *webhooks.FeedbackSubmitted) error {
+//	    if data.Response.Score != nil && *data.Response.Score <= 6 {
+//	        return alertSupport(ctx, data.Contact.Email)
+//	    }
+//	    return nil
+//	})
+func (h *Handler) OnFeedbackSubmitted(fn func(ctx context.Context, event *Event, data *FeedbackSubmitted) error) {
+	h.On(EventFeedbackSubmitted, typed(fn))
+}
+
+// OnFeedbackRequestSent registers fn to be called when a feedback request is
+// sent to a contact.
+func (h *Handler) OnFeedbackRequestSent(fn func(ctx context.Context, event *Event, data *FeedbackRequestSent) error) {
+	h.On(EventFeedbackRequestSent, typed(fn))
+}
+
+// OnContactDeleted registers fn to be called when a contact is deleted.
+func (h *Handler) OnContactDeleted(fn func(ctx context.Context, event *Event, data *ContactDeleted) error) {
+	h.On(EventContactDeleted, typed(fn))
+}
+
+// typed adapts a handler for a typed payload to an EventHandlerFunc.
+func typed[T any](fn func(ctx context.Context, event *Event, data *T) error) EventHandlerFunc {
+	return func(ctx context.Context, event *Event) error {
+		var data T
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("%w: failed to decode %s data: %v", ErrInvalidPayload, event.Type, err)
+		}
+		return fn(ctx, event, &data)
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		h.logError(fmt.Errorf("webhooks: failed to read payload: %w", err))
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.ConstructEvent(payload, r.Header.Get(SignatureHeader))
+	if err != nil {
+		h.logError(err)
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrMissingSignature) || errors.Is(err, ErrInvalidSignature) || errors.Is(err, ErrTimestampOutsideTolerance) {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	if h.replay != nil && h.replay.MarkSeen(event.ID, h.replayTTL()) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), event); err != nil {
+		if h.replay != nil {
+			h.replay.Forget(event.ID)
+		}
+		h.logError(err)
+		// Redelivering an undecodable payload cannot succeed.
+		if errors.Is(err, ErrInvalidPayload) {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "event handler failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// ConstructEvent verifies the signature of payload and decodes it into an
+// Event. It is useful when integrating with a router that does not accept an
+// http.Handler.
+//
+// Events without an ID are rejected with ErrInvalidPayload, since they
+// cannot be protected against replays.
+//
+// Example:
+//
+//	event, err := handler.ConstructEvent(payload, r.Header.Get(webhooks.SignatureHeader))
+//	if err != nil {
+//	    http.Error(w, "invalid webhook", http.StatusUnauthorized)
+//	    return
+//	}
+func (h *Handler) ConstructEvent(payload []byte, signature string) (*Event, error) {
+	if err := Verify(payload, signature, h.secret, h.tolerance); err != nil {
+		return nil, err
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode event: %v", ErrInvalidPayload, err)
+	}
+	if event.ID == "" {
+		return nil, fmt.Errorf("%w: event has no ID", ErrInvalidPayload)
+	}
+	return &event, nil
+}
+
+// Dispatch calls the handlers registered for the event's type, stopping at
+// the first error. Events without registered handlers are ignored.
+func (h *Handler) Dispatch(ctx context.Context, event *Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[event.Type]
+	h.mu.RUnlock()
+
+	for _, fn := range handlers {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayTTL returns how long event IDs are remembered. Deliveries older than
+// the tolerance are rejected anyway, so IDs only need to outlive it.
+func (h *Handler) replayTTL() time.Duration {
+	if h.tolerance <= 0 {
+		return 24 * time.Hour
+	}
+	return 2 * h.tolerance
+}
+
+// logError reports err to the configured error log, if any.
+func (h *Handler) logError(err error) {
+	if h.onError != nil {
+		h.onError(err)
+	}
+}
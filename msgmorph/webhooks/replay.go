@@ -0,0 +1,61 @@
+package webhooks
+
+import (
+	"sync"
+	"time"
+)
+
+// ReplayStore remembers the IDs of handled events so that replayed
+// deliveries can be detected.
+type ReplayStore interface {
+	// MarkSeen records id for at least ttl and reports whether it had
+	// already been recorded.
+	MarkSeen(id string, ttl time.Duration) bool
+
+	// Forget removes id, allowing a failed delivery to be retried.
+	Forget(id string)
+}
+
+// MemoryReplayStore is an in-memory ReplayStore. It is safe for concurrent
+// use, but is not shared between processes.
+type MemoryReplayStore struct {
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	lastGC time.Time
+}
+
+// NewMemoryReplayStore creates an empty MemoryReplayStore.
+func NewMemoryReplayStore() *MemoryReplayStore {
+	return &MemoryReplayStore{
+		seen: make(map[string]time.Time),
+	}
+}
+
+// MarkSeen implements ReplayStore.
+func (s *MemoryReplayStore) MarkSeen(id string, ttl time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastGC) > ttl {
+		for key, expires := range s.seen {
+			if now.After(expires) {
+				delete(s.seen, key)
+			}
+		}
+		s.lastGC = now
+	}
+
+	if expires, ok := s.seen[id]; ok && now.Before(expires) {
+		return true
+	}
+	s.seen[id] = now.Add(ttl)
+	return false
+}
+
+// Forget implements ReplayStore.
+func (s *MemoryReplayStore) Forget(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.seen, id)
+}
@@ -0,0 +1,130 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader is the HTTP header that carries the webhook signature.
+//
+// Its value has the form "t=<unix timestamp>,v1=<hex signature>", where the
+// signature is the HMAC-SHA256 of "<timestamp>.<payload>" keyed with the
+// endpoint's signing secret. Several v1 entries may be present while a
+// secret is being rotated.
+const SignatureHeader = "MsgMorph-Signature"
+
+// DefaultTolerance is the maximum age of a webhook delivery accepted by
+// default.
+const DefaultTolerance = 5 * time.Minute
+
+// Signature verification errors.
+var (
+	// ErrEmptySecret is returned when the signing secret is empty, for
+	// example because the environment variable holding it is not set.
+	// Anyone could sign deliveries with an empty secret.
+	ErrEmptySecret = errors.New("webhooks: empty signing secret")
+
+	// ErrMissingSignature is returned when the signature header is missing
+	// or malformed.
+	ErrMissingSignature = errors.New("webhooks: missing or malformed signature header")
+
+	// ErrInvalidSignature is returned when no signature matches the payload.
+	ErrInvalidSignature = errors.New("webhooks: signature does not match payload")
+
+	// ErrTimestampOutsideTolerance is returned when the delivery is older
+	// (or further in the future) than the allowed tolerance.
+	ErrTimestampOutsideTolerance = errors.New("webhooks: timestamp outside of tolerance")
+)
+
+// Sign computes the signature header value for payload, signed with secret
+// at time t.
+//
+// Sign is useful for testing webhook handlers.
+//
+// Example:
+//
+//	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(payload))
+//	req.Header.Set(webhooks.SignatureHeader, webhooks.Sign(payload, secret, time.Now()))
+func Sign(payload []byte, secret string, t time.Time) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, computeSignature(timestamp, payload, secret))
+}
+
+// Verify checks that header is a valid signature of payload for secret, and
+// that it was created within tolerance of the current time. A tolerance of
+// zero or less disables the timestamp check.
+//
+// Returns ErrEmptySecret if secret is empty, and ErrMissingSignature,
+// ErrInvalidSignature or ErrTimestampOutsideTolerance if verification fails.
+//
+// Example:
+//
+//	payload, _ := io.ReadAll(r.Body)
+//	err := webhooks.Verify(payload, r.Header.Get(webhooks.SignatureHeader), secret, webhooks.DefaultTolerance)
+//	if err != nil {
+//	    http.Error(w, "invalid signature", http.StatusUnauthorized)
+//	    return
+//	}
+func Verify(payload []byte, header, secret string, tolerance time.Duration) error {
+	if secret == "" {
+		return ErrEmptySecret
+	}
+
+	var (
+		timestamp  string
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrMissingSignature
+	}
+
+	expected := computeSignature(timestamp, payload, secret)
+	valid := false
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if tolerance > 0 {
+		age := time.Since(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrTimestampOutsideTolerance
+		}
+	}
+
+	return nil
+}
+
+// computeSignature returns the hex-encoded HMAC-SHA256 of "timestamp.payload".
+func computeSignature(timestamp string, payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}